@@ -0,0 +1,50 @@
+package ansi
+
+// Lighten returns c with its lightness, as in [HSL], increased by
+// amount, in the range 0-1. A negative amount darkens c.
+func Lighten(c Color, amount float32) RGB {
+	hsl := HSLFromColor(c)
+	hsl.L = _FClamp(0, hsl.L+amount, 1)
+	return _RoundHSL(hsl)
+}
+
+// Darken returns c with its lightness, as in [HSL], decreased by
+// amount, in the range 0-1. A negative amount lightens c.
+func Darken(c Color, amount float32) RGB {
+	return Lighten(c, -amount)
+}
+
+// Saturate returns c with its saturation, as in [HSL], increased
+// by amount, in the range 0-1. A negative amount desaturates c.
+func Saturate(c Color, amount float32) RGB {
+	hsl := HSLFromColor(c)
+	hsl.S = _FClamp(0, hsl.S+amount, 1)
+	return _RoundHSL(hsl)
+}
+
+// Desaturate returns c with its saturation, as in [HSL], decreased
+// by amount, in the range 0-1. A negative amount saturates c.
+func Desaturate(c Color, amount float32) RGB {
+	return Saturate(c, -amount)
+}
+
+// Invert returns the complement of each component of c.
+func Invert(c Color) RGB {
+	r, g, b := c.RGB()
+	return RGB{255 - r, 255 - g, 255 - b}
+}
+
+// Mix returns the color at position t, in the range 0-1, between
+// a and b, where 0 is a and 1 is b, by interpolating their red,
+// green and blue components. For other color spaces, see
+// [Gradient].
+func Mix(a, b Color, t float32) RGB {
+	return _Interpolate(a, b, _FClamp(0, t, 1), SpaceRGB)
+}
+
+// Composite returns the color seen when c, with the given opacity,
+// in the range 0-1, is laid over the opaque color bg, where 0 is
+// fully transparent and 1 is fully opaque.
+func Composite(c Color, alpha float32, bg Color) RGB {
+	return Mix(bg, c, alpha)
+}
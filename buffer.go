@@ -109,6 +109,89 @@ func (b *Builder) UnBGColor() { b.buf = append(b.buf, UnBGColor()...) }
 // default.
 func (b *Builder) UnFGColor() { b.buf = append(b.buf, UnFGColor()...) }
 
+// Dim appends a sequence to apply the dim style.
+func (b *Builder) Dim() { b.buf = append(b.buf, Dim()...) }
+
+// Blink appends a sequence to apply the slow blink style.
+func (b *Builder) Blink() { b.buf = append(b.buf, Blink()...) }
+
+// RapidBlink appends a sequence to apply the rapid blink style.
+func (b *Builder) RapidBlink() { b.buf = append(b.buf, RapidBlink()...) }
+
+// Reverse appends a sequence to swap the foreground and
+// background colors.
+func (b *Builder) Reverse() { b.buf = append(b.buf, Reverse()...) }
+
+// Conceal appends a sequence to hide the text.
+func (b *Builder) Conceal() { b.buf = append(b.buf, Conceal()...) }
+
+// DoubleUnderline appends a sequence to apply the double
+// underline style.
+func (b *Builder) DoubleUnderline() { b.buf = append(b.buf, DoubleUnderline()...) }
+
+// Overline appends a sequence to apply the overline style.
+func (b *Builder) Overline() { b.buf = append(b.buf, Overline()...) }
+
+// Superscript appends a sequence to apply the superscript style.
+func (b *Builder) Superscript() { b.buf = append(b.buf, Superscript()...) }
+
+// Subscript appends a sequence to apply the subscript style.
+func (b *Builder) Subscript() { b.buf = append(b.buf, Subscript()...) }
+
+// UnDim appends a sequence to disable dim style.
+func (b *Builder) UnDim() { b.buf = append(b.buf, UnDim()...) }
+
+// UnBlink appends a sequence to disable both blink styles.
+func (b *Builder) UnBlink() { b.buf = append(b.buf, UnBlink()...) }
+
+// UnRapidBlink appends a sequence to disable both blink styles.
+func (b *Builder) UnRapidBlink() { b.buf = append(b.buf, UnRapidBlink()...) }
+
+// UnReverse appends a sequence to restore the foreground and
+// background colors to their places.
+func (b *Builder) UnReverse() { b.buf = append(b.buf, UnReverse()...) }
+
+// UnConceal appends a sequence to reveal the text.
+func (b *Builder) UnConceal() { b.buf = append(b.buf, UnConceal()...) }
+
+// UnDoubleUnderline appends a sequence to disable underline
+// style, double or not.
+func (b *Builder) UnDoubleUnderline() { b.buf = append(b.buf, UnDoubleUnderline()...) }
+
+// UnOverline appends a sequence to disable overline style.
+func (b *Builder) UnOverline() { b.buf = append(b.buf, UnOverline()...) }
+
+// UnSuperscript appends a sequence to disable both superscript
+// and subscript styles.
+func (b *Builder) UnSuperscript() { b.buf = append(b.buf, UnSuperscript()...) }
+
+// UnSubscript appends a sequence to disable both superscript
+// and subscript styles.
+func (b *Builder) UnSubscript() { b.buf = append(b.buf, UnSubscript()...) }
+
+// StyleUnderline appends a sequence to apply an underline of the
+// given style, which is a plain underline sequence unless enabled
+// by [SetUnderlineStyles].
+func (b *Builder) StyleUnderline(s UnderlineStyle) { b.buf = append(b.buf, StyleUnderline(s)...) }
+
+// UnderlineColor appends a sequence to set the underline color to
+// the specified color.
+func (b *Builder) UnderlineColor(c Color) { b.buf = append(b.buf, UnderlineColor(c)...) }
+
+// UnUnderlineColor appends a sequence to reset the underline color
+// to the color of the text.
+func (b *Builder) UnUnderlineColor() { b.buf = append(b.buf, UnUnderlineColor()...) }
+
+// FGGradient appends s with its foreground colored by g, spread
+// over its characters, followed by a sequence to reset the
+// foreground color.
+func (b *Builder) FGGradient(s string, g Gradient) { b.buf = append(b.buf, FGGradient(s, g)...) }
+
+// BGGradient appends s with its background colored by g, spread
+// over its characters, followed by a sequence to reset the
+// background color.
+func (b *Builder) BGGradient(s string, g Gradient) { b.buf = append(b.buf, BGGradient(s, g)...) }
+
 // String returns the accumulated string in the builder's buffer.
 func (b *Builder) String() string {
 	return string(b.buf)
@@ -186,6 +269,59 @@ func (b *Builder) CursorRight(n int) { b.buf = append(b.buf, CursorRight(n)...)
 // ANSI sequence uses 1-indexed coordinates.
 func (b *Builder) MoveTo(r, c int) { b.buf = append(b.buf, MoveTo(r, c)...) }
 
+// CursorNextLine appends a sequence to move the cursor to the
+// start of the line n rows down.
+func (b *Builder) CursorNextLine(n int) { b.buf = append(b.buf, CursorNextLine(n)...) }
+
+// CursorPrevLine appends a sequence to move the cursor to the
+// start of the line n rows up.
+func (b *Builder) CursorPrevLine(n int) { b.buf = append(b.buf, CursorPrevLine(n)...) }
+
+// MoveToColumn appends a sequence to move the cursor to the given
+// column of the current row. The column is 0-indexed, albeit the
+// ANSI sequence uses 1-indexed columns.
+func (b *Builder) MoveToColumn(c int) { b.buf = append(b.buf, MoveToColumn(c)...) }
+
+// MoveToRow appends a sequence to move the cursor to the given
+// row, keeping the current column. The row is 0-indexed, albeit
+// the ANSI sequence uses 1-indexed rows.
+func (b *Builder) MoveToRow(r int) { b.buf = append(b.buf, MoveToRow(r)...) }
+
+// SaveCursor appends a sequence to save the position of the
+// cursor, alongside the current style.
+func (b *Builder) SaveCursor() { b.buf = append(b.buf, SaveCursor()...) }
+
+// RestoreCursor appends a sequence to restore the state saved by
+// [Builder.SaveCursor].
+func (b *Builder) RestoreCursor() { b.buf = append(b.buf, RestoreCursor()...) }
+
+// SavePosition appends a sequence to save only the position of
+// the cursor.
+func (b *Builder) SavePosition() { b.buf = append(b.buf, SavePosition()...) }
+
+// RestorePosition appends a sequence to restore the position
+// saved by [Builder.SavePosition].
+func (b *Builder) RestorePosition() { b.buf = append(b.buf, RestorePosition()...) }
+
+// TabForward appends a sequence to move the cursor forwards by n
+// tab stops.
+func (b *Builder) TabForward(n int) { b.buf = append(b.buf, TabForward(n)...) }
+
+// TabBackward appends a sequence to move the cursor backwards by
+// n tab stops.
+func (b *Builder) TabBackward(n int) { b.buf = append(b.buf, TabBackward(n)...) }
+
+// SetTabStop appends a sequence to set a tab stop at the column
+// of the cursor.
+func (b *Builder) SetTabStop() { b.buf = append(b.buf, SetTabStop()...) }
+
+// ClearTabStop appends a sequence to clear the tab stop at the
+// column of the cursor.
+func (b *Builder) ClearTabStop() { b.buf = append(b.buf, ClearTabStop()...) }
+
+// ClearAllTabStops appends a sequence to clear every tab stop.
+func (b *Builder) ClearAllTabStops() { b.buf = append(b.buf, ClearAllTabStops()...) }
+
 // ScrollUp appends a sequence to scroll the screen up by n lines.
 func (b *Builder) ScrollUp(n int) { b.buf = append(b.buf, ScrollUp(n)...) }
 
@@ -193,12 +329,92 @@ func (b *Builder) ScrollUp(n int) { b.buf = append(b.buf, ScrollUp(n)...) }
 // lines.
 func (b *Builder) ScrollDown(n int) { b.buf = append(b.buf, ScrollDown(n)...) }
 
+// SetScrollRegion appends a sequence to restrict scrolling to the
+// rows from top to bottom, both inclusive. Both parameters are
+// 0-indexed, albeit the ANSI sequence uses 1-indexed rows.
+func (b *Builder) SetScrollRegion(top, bottom int) {
+	b.buf = append(b.buf, SetScrollRegion(top, bottom)...)
+}
+
+// ResetScrollRegion appends a sequence to make the scroll region
+// span the entire screen.
+func (b *Builder) ResetScrollRegion() { b.buf = append(b.buf, ResetScrollRegion()...) }
+
+// EnterMarginMode appends a sequence to enable the left and right
+// margins.
+func (b *Builder) EnterMarginMode() { b.buf = append(b.buf, EnterMarginMode()...) }
+
+// LeaveMarginMode appends a sequence to disable the left and right
+// margins.
+func (b *Builder) LeaveMarginMode() { b.buf = append(b.buf, LeaveMarginMode()...) }
+
+// SetMargins appends a sequence to restrict the scroll region to
+// the columns from left to right, both inclusive. Both parameters
+// are 0-indexed, albeit the ANSI sequence uses 1-indexed columns.
+func (b *Builder) SetMargins(left, right int) { b.buf = append(b.buf, SetMargins(left, right)...) }
+
+// ResetMargins appends a sequence to make the scroll region span
+// every column.
+func (b *Builder) ResetMargins() { b.buf = append(b.buf, ResetMargins()...) }
+
+// EnterOriginMode appends a sequence to make the positions of the
+// cursor relative to the scroll region.
+func (b *Builder) EnterOriginMode() { b.buf = append(b.buf, EnterOriginMode()...) }
+
+// LeaveOriginMode appends a sequence to make the positions of the
+// cursor relative to the screen.
+func (b *Builder) LeaveOriginMode() { b.buf = append(b.buf, LeaveOriginMode()...) }
+
 // EraseScreen appends a sequence to clear the entire screen.
 func (b *Builder) EraseScreen() { b.buf = append(b.buf, EraseScreen()...) }
 
 // EraseLine appends a sequence to clear the current line.
 func (b *Builder) EraseLine() { b.buf = append(b.buf, EraseLine()...) }
 
+// EraseToEndOfScreen appends a sequence to clear the screen from
+// the cursor to its end.
+func (b *Builder) EraseToEndOfScreen() { b.buf = append(b.buf, EraseToEndOfScreen()...) }
+
+// EraseToStartOfScreen appends a sequence to clear the screen from
+// its start to the cursor.
+func (b *Builder) EraseToStartOfScreen() { b.buf = append(b.buf, EraseToStartOfScreen()...) }
+
+// EraseScrollback appends a sequence to clear the scrollback
+// buffer.
+func (b *Builder) EraseScrollback() { b.buf = append(b.buf, EraseScrollback()...) }
+
+// EraseToEndOfLine appends a sequence to clear the current line
+// from the cursor to its end.
+func (b *Builder) EraseToEndOfLine() { b.buf = append(b.buf, EraseToEndOfLine()...) }
+
+// EraseToStartOfLine appends a sequence to clear the current line
+// from its start to the cursor.
+func (b *Builder) EraseToStartOfLine() { b.buf = append(b.buf, EraseToStartOfLine()...) }
+
+// EraseChars appends a sequence to clear n characters from the
+// cursor onwards, without moving the rest of the line.
+func (b *Builder) EraseChars(n int) { b.buf = append(b.buf, EraseChars(n)...) }
+
+// InsertLines appends a sequence to insert n blank lines at the
+// cursor's row, pushing the rows below down.
+func (b *Builder) InsertLines(n int) { b.buf = append(b.buf, InsertLines(n)...) }
+
+// DeleteLines appends a sequence to delete n lines from the
+// cursor's row downwards, pulling the rows below up.
+func (b *Builder) DeleteLines(n int) { b.buf = append(b.buf, DeleteLines(n)...) }
+
+// InsertChars appends a sequence to insert n blanks at the
+// cursor, pushing the rest of the line to the right.
+func (b *Builder) InsertChars(n int) { b.buf = append(b.buf, InsertChars(n)...) }
+
+// DeleteChars appends a sequence to delete n characters from the
+// cursor onwards, pulling the rest of the line to the left.
+func (b *Builder) DeleteChars(n int) { b.buf = append(b.buf, DeleteChars(n)...) }
+
+// Repeat appends a sequence to repeat the last printed character
+// n more times.
+func (b *Builder) Repeat(n int) { b.buf = append(b.buf, Repeat(n)...) }
+
 // StyleCursor appends a sequence to set the cursor style. The
 // CursorStyle value is 0-indexed but the ANSI sequence uses
 // 1-indexed values.
@@ -1,7 +1,11 @@
 package ansi
 
 import (
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"unicode"
 )
 
 // Color defines an interface for all possible forms of
@@ -32,6 +36,123 @@ func RGBFromHex(hex int) RGB {
 	}
 }
 
+// ParseColor parses a color from its textual representation,
+// which may be:
+//
+//   - a name, see [NamedColor];
+//   - a hex color, either #rgb or #rrggbb;
+//   - rgb(r, g, b), where each component is either a number in
+//     the range 0-255 or a percentage;
+//   - hsl(h, s, l), where the hue is in degrees and both the
+//     saturation and the lightness are percentages;
+//   - ansi:n, an index in the xterm 256 color palette, parsed
+//     as an [ANSI256].
+//
+// The components of rgb and hsl may be separated by commas or
+// spaces. Case and surrounding spaces are ignored.
+func ParseColor(s string) (Color, error) {
+	c, ok := _ParseColor(strings.ToLower(strings.TrimSpace(s)))
+	if !ok {
+		return nil, fmt.Errorf("ansi: invalid color %q", s)
+	}
+
+	return c, nil
+}
+
+func _ParseColor(s string) (Color, bool) {
+	if hex, ok := strings.CutPrefix(s, "#"); ok {
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return nil, false
+		}
+
+		switch len(hex) {
+		case 3:
+			r, g, b := v>>8&0xF, v>>4&0xF, v&0xF
+			return RGB{uint8(r * 0x11), uint8(g * 0x11), uint8(b * 0x11)}, true
+		case 6:
+			return RGBFromHex(int(v)), true
+		}
+
+		return nil, false
+	}
+
+	if index, ok := strings.CutPrefix(s, "ansi:"); ok {
+		n, err := strconv.ParseUint(index, 10, 8)
+		if err != nil {
+			return nil, false
+		}
+
+		return ANSI256(n), true
+	}
+
+	if args, ok := strings.CutSuffix(s, ")"); ok {
+		name, args, ok := strings.Cut(args, "(")
+		if !ok {
+			return nil, false
+		}
+
+		return _ParseColorFunc(strings.TrimSpace(name), args)
+	}
+
+	if c, ok := NamedColor(s); ok {
+		return c, true
+	}
+
+	return nil, false
+}
+
+// _ParseColorFunc parses the arguments of the functional forms
+// of [ParseColor].
+func _ParseColorFunc(name, args string) (Color, bool) {
+	parts := strings.FieldsFunc(args, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	var v [3]float32
+	var percent [3]bool
+	for i, part := range parts {
+		part, percent[i] = strings.CutSuffix(part, "%")
+		if i == 0 && name == "hsl" {
+			part = strings.TrimSuffix(part, "deg")
+		}
+
+		f, err := strconv.ParseFloat(part, 32)
+		if err != nil {
+			return nil, false
+		}
+
+		v[i] = float32(f)
+	}
+
+	switch name {
+	case "rgb":
+		var c [3]uint8
+		for i := range c {
+			if percent[i] {
+				v[i] = v[i] * 255 / 100
+			}
+
+			c[i] = uint8(_FClamp(0, v[i], 255) + 0.5)
+		}
+
+		return RGB{c[0], c[1], c[2]}, true
+
+	case "hsl":
+		if percent[0] {
+			return nil, false
+		}
+
+		return HSL{v[0], v[1] / 100, v[2] / 100}, true
+	}
+
+	return nil, false
+}
+
 // RGB is a color defined by its red, green and blue
 // components.
 type RGB struct{ R, G, B uint8 }
@@ -40,6 +161,58 @@ type RGB struct{ R, G, B uint8 }
 // satisfying the [Color] interface.
 func (c RGB) RGB() (uint8, uint8, uint8) { return c.R, c.G, c.B }
 
+// ANSI16 is a color of the basic 16 color palette. Contrary to
+// other colors, it is emitted as an index into the palette, so the
+// actual color is defined by the terminal's theme. Its RGB values
+// are those of the default xterm palette.
+type ANSI16 uint8
+
+const (
+	Black ANSI16 = iota
+	Red
+	Green
+	Yellow
+	Blue
+	Magenta
+	Cyan
+	White
+	BrightBlack
+	BrightRed
+	BrightGreen
+	BrightYellow
+	BrightBlue
+	BrightMagenta
+	BrightCyan
+	BrightWhite
+)
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface. Only the 4 lower
+// bits of the index are taken into account.
+func (c ANSI16) RGB() (uint8, uint8, uint8) { return _Palette[c&0xF].RGB() }
+
+// ANSI256 is a color of the xterm 256 color palette. Contrary to
+// other colors, it is emitted as an index into the palette, so the
+// first 16 colors are defined by the terminal's theme. Its RGB
+// values are those of the default xterm palette.
+type ANSI256 uint8
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c ANSI256) RGB() (uint8, uint8, uint8) { return _Palette[c].RGB() }
+
+// _Canonical returns c as an [RGB], unless it is an indexed
+// color, in which case it is returned as is. It serves to avoid
+// recalculations while keeping the information about indices.
+func _Canonical(c Color) Color {
+	switch c := c.(type) {
+	case RGB, ANSI16, ANSI256:
+		return c
+	}
+
+	return RGBFromColor(c)
+}
+
 // HSL is a color defined by its hue, saturation and
 // lightness components.
 type HSL struct{ H, S, L float32 }
@@ -47,12 +220,48 @@ type HSL struct{ H, S, L float32 }
 // RGB returns the red, green and blue components,
 // satisfying the [Color] interface.
 func (c HSL) RGB() (uint8, uint8, uint8) {
-	c.H = _FMod(c.H, 360)
+	r, g, b := c._Components()
+	return uint8(r * 255), uint8(g * 255), uint8(b * 255)
+}
+
+// HSLFromColor takes a concrete [Color] and returns its
+// [HSL] representation. Note that [HSL.RGB] truncates the
+// components, so converting back may give slightly darker
+// components than those of c.
+func HSLFromColor(c Color) HSL {
+	h, mx, mn := _Hue(c)
+
+	l := (mx + mn) / 2
+	if mx == mn {
+		return HSL{h, 0, l}
+	}
+
+	return HSL{h, (mx - mn) / (1 - _FAbs(2*l-1)), l}
+}
+
+// _RoundHSL returns c as an [RGB], like [HSL.RGB], but rounding
+// the components rather than truncating them, so a color taken
+// to HSL and back is left unchanged.
+func _RoundHSL(c HSL) RGB {
+	r, g, b := c._Components()
+	return RGB{_Byte(r), _Byte(g), _Byte(b)}
+}
+
+// _Components returns the red, green and blue components of c, in
+// the range 0-1.
+func (c HSL) _Components() (float32, float32, float32) {
 	c.S = _FClamp(0, c.S, 1)
 	c.L = _FClamp(0, c.L, 1)
 
 	C := (1 - _FAbs(2*c.L-1)) * c.S
-	H := c.H / 60
+	return _HueRGB(c.H, C, c.L-C/2)
+}
+
+// _HueRGB returns the red, green and blue components, in the range
+// 0-1, of the color of hue h, in degrees, chroma C and smallest
+// component m, both in the range 0-1.
+func _HueRGB(h, C, m float32) (float32, float32, float32) {
+	H := _FMod(h, 360) / 60
 	X := C * (1 - _FAbs(_FMod(H, 2)-1))
 
 	var r, g, b float32
@@ -65,8 +274,32 @@ func (c HSL) RGB() (uint8, uint8, uint8) {
 	case 5: r, b = C, X
 	}
 
-	m := c.L - C/2
-	return uint8((r + m) * 255), uint8((g + m) * 255), uint8((b + m) * 255)
+	return r + m, g + m, b + m
+}
+
+// _Hue returns the hue of c, in degrees, and its largest and
+// smallest components, both in the range 0-1.
+func _Hue(c Color) (h, mx, mn float32) {
+	R, G, B := c.RGB()
+	r, g, b := float32(R)/255, float32(G)/255, float32(B)/255
+
+	mx, mn = max(r, g, b), min(r, g, b)
+	d := mx - mn
+
+	switch {
+	case d == 0: h = 0
+	case mx == r: h = 60 * _FMod((g-b)/d, 6)
+	case mx == g: h = 60 * ((b-r)/d + 2)
+	default:      h = 60 * ((r-g)/d + 4)
+	}
+
+	return h, mx, mn
+}
+
+// _Byte converts a component in the range 0-1 to the range 0-255,
+// rounding it to the nearest integer.
+func _Byte(x float32) uint8 {
+	return uint8(_FClamp(0, x, 1)*255 + 0.5)
 }
 
 func _FClamp(mn, x, mx float32) float32 {
@@ -0,0 +1,257 @@
+package ansi
+
+import (
+	"math"
+)
+
+// HSV is a color defined by its hue, in degrees, saturation and
+// value components, both in the range 0-1.
+type HSV struct{ H, S, V float32 }
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c HSV) RGB() (uint8, uint8, uint8) {
+	c.S = _FClamp(0, c.S, 1)
+	c.V = _FClamp(0, c.V, 1)
+
+	C := c.V * c.S
+	r, g, b := _HueRGB(c.H, C, c.V-C)
+	return _Byte(r), _Byte(g), _Byte(b)
+}
+
+// HSVFromColor takes a concrete [Color] and returns its
+// [HSV] representation.
+func HSVFromColor(c Color) HSV {
+	h, mx, mn := _Hue(c)
+	if mx == 0 {
+		return HSV{h, 0, 0}
+	}
+
+	return HSV{h, (mx - mn) / mx, mx}
+}
+
+// HWB is a color defined by its hue, in degrees, whiteness and
+// blackness components, both in the range 0-1. If the whiteness
+// and the blackness add up to more than 1, the color is a gray.
+type HWB struct{ H, W, B float32 }
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c HWB) RGB() (uint8, uint8, uint8) {
+	c.W = _FClamp(0, c.W, 1)
+	c.B = _FClamp(0, c.B, 1)
+
+	if sum := c.W + c.B; sum >= 1 {
+		gray := _Byte(c.W / sum)
+		return gray, gray, gray
+	}
+
+	r, g, b := _HueRGB(c.H, 1-c.W-c.B, c.W)
+	return _Byte(r), _Byte(g), _Byte(b)
+}
+
+// HWBFromColor takes a concrete [Color] and returns its
+// [HWB] representation.
+func HWBFromColor(c Color) HWB {
+	h, mx, mn := _Hue(c)
+	return HWB{h, mn, 1 - mx}
+}
+
+// Lab is a color of the CIELAB color space, defined by its
+// lightness, in the range 0-100, and its green-red and blue-yellow
+// axes, usually in the range -128-127, under the D65 illuminant.
+// Colors outside of the sRGB gamut are clipped.
+type Lab struct{ L, A, B float32 }
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c Lab) RGB() (uint8, uint8, uint8) {
+	fy := (float64(c.L) + 16) / 116
+	fx := fy + float64(c.A)/500
+	fz := fy - float64(c.B)/200
+
+	x := _D65X * _LabInverse(fx)
+	y := _D65Y * _LabInverse(fy)
+	z := _D65Z * _LabInverse(fz)
+
+	return _FromLinear(
+		+3.2404542*x-1.5371385*y-0.4985314*z,
+		-0.9692660*x+1.8760108*y+0.0415560*z,
+		+0.0556434*x-0.2040259*y+1.0572252*z,
+	)
+}
+
+// LabFromColor takes a concrete [Color] and returns its
+// [Lab] representation.
+func LabFromColor(c Color) Lab {
+	r, g, b := _LinearRGB(c)
+
+	x := 0.4124564*r + 0.3575761*g + 0.1804375*b
+	y := 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z := 0.0193339*r + 0.1191920*g + 0.9503041*b
+
+	fx := _LabForward(x / _D65X)
+	fy := _LabForward(y / _D65Y)
+	fz := _LabForward(z / _D65Z)
+
+	return Lab{
+		float32(116*fy - 16),
+		float32(500 * (fx - fy)),
+		float32(200 * (fy - fz)),
+	}
+}
+
+// LCh is the cylindrical form of [Lab], defined by its lightness,
+// in the range 0-100, chroma and hue, in degrees.
+type LCh struct{ L, C, H float32 }
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c LCh) RGB() (uint8, uint8, uint8) {
+	a, b := _FromPolar(c.C, c.H)
+	return Lab{c.L, a, b}.RGB()
+}
+
+// LChFromColor takes a concrete [Color] and returns its
+// [LCh] representation.
+func LChFromColor(c Color) LCh {
+	lab := LabFromColor(c)
+	C, h := _ToPolar(lab.A, lab.B)
+	return LCh{lab.L, C, h}
+}
+
+// OKLab is a color of the Oklab color space, defined by its
+// lightness, in the range 0-1, and its green-red and blue-yellow
+// axes, usually in the range -0.4-0.4. Being perceptually uniform,
+// it is well suited for mixing colors and measuring how different
+// they look. Colors outside of the sRGB gamut are clipped.
+type OKLab struct{ L, A, B float32 }
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c OKLab) RGB() (uint8, uint8, uint8) {
+	L, A, B := float64(c.L), float64(c.A), float64(c.B)
+
+	l := L + 0.3963377774*A + 0.2158037573*B
+	m := L - 0.1055613458*A - 0.0638541728*B
+	s := L - 0.0894841775*A - 1.2914855480*B
+
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	return _FromLinear(
+		+4.0767416621*l-3.3077115913*m+0.2309699292*s,
+		-1.2684380046*l+2.6097574011*m-0.3413193965*s,
+		-0.0041960863*l-0.7034186147*m+1.7076147010*s,
+	)
+}
+
+// OKLabFromColor takes a concrete [Color] and returns its
+// [OKLab] representation.
+func OKLabFromColor(c Color) OKLab {
+	r, g, b := _LinearRGB(c)
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return OKLab{
+		float32(0.2104542553*l + 0.7936177850*m - 0.0040720468*s),
+		float32(1.9779984951*l - 2.4285922050*m + 0.4505937099*s),
+		float32(0.0259040371*l + 0.7827717662*m - 0.8086757660*s),
+	}
+}
+
+// OKLCH is the cylindrical form of [OKLab], defined by its
+// lightness, in the range 0-1, chroma and hue, in degrees.
+type OKLCH struct{ L, C, H float32 }
+
+// RGB returns the red, green and blue components,
+// satisfying the [Color] interface.
+func (c OKLCH) RGB() (uint8, uint8, uint8) {
+	a, b := _FromPolar(c.C, c.H)
+	return OKLab{c.L, a, b}.RGB()
+}
+
+// OKLCHFromColor takes a concrete [Color] and returns its
+// [OKLCH] representation.
+func OKLCHFromColor(c Color) OKLCH {
+	lab := OKLabFromColor(c)
+	C, h := _ToPolar(lab.A, lab.B)
+	return OKLCH{lab.L, C, h}
+}
+
+// White point of the D65 illuminant.
+const (
+	_D65X = 0.95047
+	_D65Y = 1.00000
+	_D65Z = 1.08883
+)
+
+func _LabForward(t float64) float64 {
+	const delta = 6.0 / 29
+
+	if t > delta*delta*delta {
+		return math.Cbrt(t)
+	}
+
+	return t/(3*delta*delta) + 4.0/29
+}
+
+func _LabInverse(t float64) float64 {
+	const delta = 6.0 / 29
+
+	if t > delta {
+		return t * t * t
+	}
+
+	return 3 * delta * delta * (t - 4.0/29)
+}
+
+// _ToPolar converts the cartesian axes a and b to chroma and hue,
+// in degrees.
+func _ToPolar(a, b float32) (float32, float32) {
+	C := math.Hypot(float64(a), float64(b))
+	h := math.Atan2(float64(b), float64(a)) * 180 / math.Pi
+	return float32(C), _FMod(float32(h), 360)
+}
+
+// _FromPolar converts chroma and hue, in degrees, to the cartesian
+// axes a and b.
+func _FromPolar(C, h float32) (float32, float32) {
+	sin, cos := math.Sincos(float64(h) * math.Pi / 180)
+	return C * float32(cos), C * float32(sin)
+}
+
+// _LinearRGB returns the components of c in linear light, that
+// is, without the sRGB gamma, in the range 0-1.
+func _LinearRGB(c Color) (float64, float64, float64) {
+	r, g, b := c.RGB()
+	return _Linear(r), _Linear(g), _Linear(b)
+}
+
+// _FromLinear returns the components of a color in linear light,
+// in the range 0-1, with the sRGB gamma applied, in the range
+// 0-255. Components out of range are clipped.
+func _FromLinear(r, g, b float64) (uint8, uint8, uint8) {
+	return _Gamma(r), _Gamma(g), _Gamma(b)
+}
+
+func _Linear(c uint8) float64 {
+	x := float64(c) / 255
+	if x <= 0.04045 {
+		return x / 12.92
+	}
+
+	return math.Pow((x+0.055)/1.055, 2.4)
+}
+
+func _Gamma(x float64) uint8 {
+	x = max(0, min(x, 1))
+	if x <= 0.0031308 {
+		x *= 12.92
+	} else {
+		x = 1.055*math.Pow(x, 1/2.4) - 0.055
+	}
+
+	return uint8(x*255 + 0.5)
+}
@@ -0,0 +1,64 @@
+package ansi
+
+// Minimum contrast ratios required by the Web Content
+// Accessibility Guidelines, see [ContrastRatio].
+const (
+	ContrastAALarge = 3   // level AA, for large text
+	ContrastAA      = 4.5 // level AA
+	ContrastAAA     = 7   // level AAA
+)
+
+// Luminance returns the relative luminance of c, as defined by
+// the Web Content Accessibility Guidelines, in the range 0-1,
+// where 0 is black and 1 is white.
+func Luminance(c Color) float32 {
+	return float32(_Luminance(c))
+}
+
+// ContrastRatio returns the contrast ratio between a and b, as
+// defined by the Web Content Accessibility Guidelines, in the
+// range 1-21. The order of the colors does not matter.
+func ContrastRatio(a, b Color) float32 {
+	la, lb := _Luminance(a), _Luminance(b)
+	return float32((max(la, lb) + 0.05) / (min(la, lb) + 0.05))
+}
+
+// ReadableOn returns a color from candidates that is legible over
+// the background bg. It is the first candidate that meets
+// [ContrastAAA], or else the first one that meets [ContrastAA],
+// or else the one of highest contrast. Without candidates, it
+// chooses between black and white.
+func ReadableOn(bg Color, candidates ...Color) Color {
+	if len(candidates) == 0 {
+		candidates = []Color{RGB{0x00, 0x00, 0x00}, RGB{0xFF, 0xFF, 0xFF}}
+	}
+
+	var aa, best Color
+	var best_ratio float32
+
+	for _, c := range candidates {
+		ratio := ContrastRatio(c, bg)
+		if ratio >= ContrastAAA {
+			return c
+		}
+
+		if aa == nil && ratio >= ContrastAA {
+			aa = c
+		}
+
+		if best == nil || ratio > best_ratio {
+			best, best_ratio = c, ratio
+		}
+	}
+
+	if aa != nil {
+		return aa
+	}
+
+	return best
+}
+
+func _Luminance(c Color) float64 {
+	r, g, b := _LinearRGB(c)
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
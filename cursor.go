@@ -12,13 +12,53 @@ const (
 
 	_MoveTo = _Csi + "%d;%dH"
 
+	_CursorNextLine = _Csi + "%dE"
+	_CursorPrevLine = _Csi + "%dF"
+	_MoveToColumn   = _Csi + "%dG"
+	_MoveToRow      = _Csi + "%dd"
+
+	_SaveCursor      = _Esc + "7"
+	_RestoreCursor   = _Esc + "8"
+	_SavePosition    = _Csi + "s"
+	_RestorePosition = _Csi + "u"
+
+	_TabForward       = _Csi + "%dI"
+	_TabBackward      = _Csi + "%dZ"
+	_SetTabStop       = _Esc + "H"
+	_ClearTabStop     = _Csi + "0g"
+	_ClearAllTabStops = _Csi + "3g"
+
 	_ScrollUp   = _Csi + "%dS"
 	_ScrollDown = _Csi + "%dT"
 
+	_SetScrollRegion   = _Csi + "%d;%dr"
+	_ResetScrollRegion = _Csi + "r"
+
+	_EnterMarginMode = _Csi + "?69h"
+	_LeaveMarginMode = _Csi + "?69l"
+	_SetMargins      = _Csi + "%d;%ds"
+	_ResetMargins    = _Csi + "s"
+
+	_EnterOriginMode = _Csi + "?6h"
+	_LeaveOriginMode = _Csi + "?6l"
+
 	_Restart     = _Esc + "c"
 	_EraseScreen = _Csi + "2J"
 	_EraseLine   = _Csi + "2K"
 
+	_EraseToEndOfScreen   = _Csi + "0J"
+	_EraseToStartOfScreen = _Csi + "1J"
+	_EraseScrollback      = _Csi + "3J"
+	_EraseToEndOfLine     = _Csi + "0K"
+	_EraseToStartOfLine   = _Csi + "1K"
+	_EraseChars           = _Csi + "%dX"
+
+	_InsertLines = _Csi + "%dL"
+	_DeleteLines = _Csi + "%dM"
+	_InsertChars = _Csi + "%d@"
+	_DeleteChars = _Csi + "%dP"
+	_Repeat      = _Csi + "%db"
+
 	_StyleCursor = _Csi + "%d q"
 
 	_ShowCursor = _Csi + "?25h"
@@ -54,6 +94,69 @@ func CursorRight(n int) string { return fmt.Sprintf(_CursorRight, n) }
 // (1, 1) in the ANSI escape sequence interface.
 func MoveTo(r, c int) string { return fmt.Sprintf(_MoveTo, r+1, c+1) }
 
+// CursorNextLine returns an escape sequence that can move
+// the cursor to the start of the line n rows down.
+func CursorNextLine(n int) string { return fmt.Sprintf(_CursorNextLine, n) }
+
+// CursorPrevLine returns an escape sequence that can move
+// the cursor to the start of the line n rows up.
+func CursorPrevLine(n int) string { return fmt.Sprintf(_CursorPrevLine, n) }
+
+// MoveToColumn returns an escape sequence that can move the
+// cursor to the given column of the current row.
+//
+// The leftmost column is indexed 0, contrary to 1 in the
+// ANSI escape sequence interface.
+func MoveToColumn(c int) string { return fmt.Sprintf(_MoveToColumn, c+1) }
+
+// MoveToRow returns an escape sequence that can move the
+// cursor to the given row, keeping the current column.
+//
+// The top row is indexed 0, contrary to 1 in the ANSI
+// escape sequence interface.
+func MoveToRow(r int) string { return fmt.Sprintf(_MoveToRow, r+1) }
+
+// SaveCursor returns an escape sequence that can save the
+// position of the cursor, alongside the current style and
+// some modes of the terminal, to be restored later by
+// [RestoreCursor].
+func SaveCursor() string { return _SaveCursor }
+
+// RestoreCursor returns an escape sequence that can
+// restore the state saved by [SaveCursor].
+func RestoreCursor() string { return _RestoreCursor }
+
+// SavePosition returns an escape sequence that can save
+// only the position of the cursor, to be restored later by
+// [RestorePosition]. Prefer [SaveCursor], which is more
+// widely supported. In margin mode, terminals take it as
+// [ResetMargins] instead.
+func SavePosition() string { return _SavePosition }
+
+// RestorePosition returns an escape sequence that can
+// restore the position saved by [SavePosition].
+func RestorePosition() string { return _RestorePosition }
+
+// TabForward returns an escape sequence that can move the
+// cursor forwards by n tab stops.
+func TabForward(n int) string { return fmt.Sprintf(_TabForward, n) }
+
+// TabBackward returns an escape sequence that can move the
+// cursor backwards by n tab stops.
+func TabBackward(n int) string { return fmt.Sprintf(_TabBackward, n) }
+
+// SetTabStop returns an escape sequence that can set a tab
+// stop at the column of the cursor.
+func SetTabStop() string { return _SetTabStop }
+
+// ClearTabStop returns an escape sequence that can clear
+// the tab stop at the column of the cursor, if any.
+func ClearTabStop() string { return _ClearTabStop }
+
+// ClearAllTabStops returns an escape sequence that can
+// clear every tab stop.
+func ClearAllTabStops() string { return _ClearAllTabStops }
+
 // ScrollUp returns an escape sequence that can scroll the
 // page up by n rows, that is, the n top rows will go out
 // of view and the content of the remaining rows will be
@@ -66,6 +169,55 @@ func ScrollUp(n int) string { return fmt.Sprintf(_ScrollUp, n) }
 // will be moved down.
 func ScrollDown(n int) string { return fmt.Sprintf(_ScrollDown, n) }
 
+// SetScrollRegion returns an escape sequence that can
+// restrict scrolling to the rows from top to bottom, both
+// inclusive. The rows outside of the region stay in place
+// when the region scrolls, either by [ScrollUp] and
+// [ScrollDown] or by writing past its bottom, which allows
+// for fixed headers and footers. It also moves the cursor
+// to the top left corner.
+//
+// The top row is indexed 0, contrary to 1 in the ANSI
+// escape sequence interface.
+func SetScrollRegion(top, bottom int) string { return fmt.Sprintf(_SetScrollRegion, top+1, bottom+1) }
+
+// ResetScrollRegion returns an escape sequence that can
+// make the scroll region span the entire screen again.
+func ResetScrollRegion() string { return _ResetScrollRegion }
+
+// EnterMarginMode returns an escape sequence that can
+// enable the left and right margins, see [SetMargins].
+func EnterMarginMode() string { return _EnterMarginMode }
+
+// LeaveMarginMode returns an escape sequence that can
+// disable the left and right margins.
+func LeaveMarginMode() string { return _LeaveMarginMode }
+
+// SetMargins returns an escape sequence that can restrict
+// the scroll region to the columns from left to right, both
+// inclusive. It only takes effect in margin mode, see
+// [EnterMarginMode].
+//
+// The leftmost column is indexed 0, contrary to 1 in the
+// ANSI escape sequence interface.
+func SetMargins(left, right int) string { return fmt.Sprintf(_SetMargins, left+1, right+1) }
+
+// ResetMargins returns an escape sequence that can make the
+// scroll region span every column again. It only takes
+// effect in margin mode, otherwise, it is taken as
+// [SavePosition].
+func ResetMargins() string { return _ResetMargins }
+
+// EnterOriginMode returns an escape sequence that can make
+// the positions of the cursor relative to the scroll region
+// and confine the cursor to it, see [SetScrollRegion] and
+// [SetMargins].
+func EnterOriginMode() string { return _EnterOriginMode }
+
+// LeaveOriginMode returns an escape sequence that can make
+// the positions of the cursor relative to the screen again.
+func LeaveOriginMode() string { return _LeaveOriginMode }
+
 // Restart resets all terminal configurations to default,
 // this includes, but not limited to, erasure of the all
 // the buffer of the terminal emulator.
@@ -80,6 +232,65 @@ func EraseScreen() string { return _EraseScreen }
 // cursor.
 func EraseLine() string { return _EraseLine }
 
+// EraseToEndOfScreen returns an escape sequence that can
+// erase from the cursor, inclusive, to the end of the
+// screen. This does not move the cursor.
+func EraseToEndOfScreen() string { return _EraseToEndOfScreen }
+
+// EraseToStartOfScreen returns an escape sequence that can
+// erase from the start of the screen to the cursor,
+// inclusive. This does not move the cursor.
+func EraseToStartOfScreen() string { return _EraseToStartOfScreen }
+
+// EraseScrollback returns an escape sequence that can
+// erase the lines saved in the scrollback buffer, those
+// that went out of view. The screen itself is kept.
+func EraseScrollback() string { return _EraseScrollback }
+
+// EraseToEndOfLine returns an escape sequence that can
+// erase from the cursor, inclusive, to the end of the
+// current line. This does not move the cursor.
+func EraseToEndOfLine() string { return _EraseToEndOfLine }
+
+// EraseToStartOfLine returns an escape sequence that can
+// erase from the start of the current line to the cursor,
+// inclusive. This does not move the cursor.
+func EraseToStartOfLine() string { return _EraseToStartOfLine }
+
+// EraseChars returns an escape sequence that can erase n
+// characters from the cursor, inclusive, replacing them by
+// blanks. This does not move the cursor nor the characters
+// after the erased ones.
+func EraseChars(n int) string { return fmt.Sprintf(_EraseChars, n) }
+
+// InsertLines returns an escape sequence that can insert n
+// blank lines at the cursor's row, pushing it and the rows
+// below down. The rows pushed past the bottom of the screen
+// are lost.
+func InsertLines(n int) string { return fmt.Sprintf(_InsertLines, n) }
+
+// DeleteLines returns an escape sequence that can delete n
+// lines from the cursor's row downwards, pulling the rows
+// below up and filling the bottom with blank lines.
+func DeleteLines(n int) string { return fmt.Sprintf(_DeleteLines, n) }
+
+// InsertChars returns an escape sequence that can insert n
+// blanks at the cursor, pushing the rest of the line to the
+// right. The characters pushed past the end of the line are
+// lost. This does not move the cursor.
+func InsertChars(n int) string { return fmt.Sprintf(_InsertChars, n) }
+
+// DeleteChars returns an escape sequence that can delete n
+// characters from the cursor onwards, pulling the rest of
+// the line to the left and filling its end with blanks.
+func DeleteChars(n int) string { return fmt.Sprintf(_DeleteChars, n) }
+
+// Repeat returns an escape sequence that can repeat the
+// last printed character n more times. Terminals disagree
+// on which characters can be repeated, so it should follow
+// a plain graphic character, never an escape sequence.
+func Repeat(n int) string { return fmt.Sprintf(_Repeat, n) }
+
 // CursorStyle defines the style of the cursor.
 type CursorStyle int
 
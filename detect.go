@@ -0,0 +1,120 @@
+package ansi
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// DetectProfile returns the color depth most likely supported by
+// the terminal behind the fd file descriptor. It takes into
+// account whether fd is a terminal and the environment variables
+// NO_COLOR, FORCE_COLOR, CLICOLOR, CLICOLOR_FORCE, TERM,
+// COLORTERM, TERM_PROGRAM and those set by common CI services.
+// Use:
+//
+//	ansi.SetProfile(ansi.DetectProfile(os.Stdout.Fd()))
+//
+// in your main function to have the emitters of this package
+// respect the capabilities of the terminal.
+func DetectProfile(fd uintptr) Profile {
+	return _DetectProfile(_IsTerminal(fd), os.Getenv)
+}
+
+func _DetectProfile(tty bool, getenv func(string) string) Profile {
+	if getenv("NO_COLOR") != "" {
+		return ProfileNoColor
+	}
+
+	forced, force := ProfileNoColor, false
+	switch strings.ToLower(getenv("FORCE_COLOR")) {
+	case "":
+	case "0", "false":
+		return ProfileNoColor
+	case "2":
+		forced, force = ProfileANSI256, true
+	case "3":
+		forced, force = ProfileTrueColor, true
+	default:
+		forced, force = ProfileANSI16, true
+	}
+
+	if v := getenv("CLICOLOR_FORCE"); !force && v != "" && v != "0" {
+		forced, force = ProfileANSI16, true
+	}
+
+	if force {
+		return min(forced, _DetectFromEnv(getenv))
+	}
+
+	if !tty || getenv("CLICOLOR") == "0" {
+		return ProfileNoColor
+	}
+
+	return _DetectFromEnv(getenv)
+}
+
+func _DetectFromEnv(getenv func(string) string) Profile {
+	term := strings.ToLower(getenv("TERM"))
+	if term == "dumb" {
+		return ProfileNoColor
+	}
+
+	colorterm := strings.ToLower(getenv("COLORTERM"))
+	if colorterm == "truecolor" || colorterm == "24bit" {
+		return ProfileTrueColor
+	}
+
+	if getenv("CI") != "" {
+		switch {
+		case getenv("GITHUB_ACTIONS") != "", getenv("GITEA_ACTIONS") != "":
+			return ProfileTrueColor
+
+		case getenv("GITLAB_CI") != "", getenv("TRAVIS") != "",
+			getenv("CIRCLECI") != "", getenv("APPVEYOR") != "",
+			getenv("BUILDKITE") != "", getenv("DRONE") != "",
+			getenv("CI_NAME") == "codeship":
+			return ProfileANSI16
+		}
+	}
+
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty":
+		return ProfileTrueColor
+	case "Apple_Terminal":
+		return ProfileANSI256
+	}
+
+	if getenv("WT_SESSION") != "" {
+		return ProfileTrueColor
+	}
+
+	switch {
+	case strings.Contains(term, "truecolor"), strings.Contains(term, "24bit"),
+		strings.HasSuffix(term, "-direct"), term == "xterm-kitty",
+		term == "xterm-ghostty", term == "alacritty", term == "wezterm":
+		return ProfileTrueColor
+
+	case strings.Contains(term, "256"):
+		return ProfileANSI256
+	}
+
+	for _, prefix := range []string{
+		"xterm", "screen", "tmux", "vt100", "vt220", "rxvt",
+		"ansi", "color", "cygwin", "linux", "konsole", "putty",
+	} {
+		if strings.HasPrefix(term, prefix) {
+			return ProfileANSI16
+		}
+	}
+
+	if colorterm != "" {
+		return ProfileANSI16
+	}
+
+	if runtime.GOOS == "windows" {
+		return ProfileTrueColor
+	}
+
+	return ProfileNoColor
+}
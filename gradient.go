@@ -0,0 +1,165 @@
+package ansi
+
+import (
+	"strings"
+)
+
+// ColorSpace is a color space in which colors are interpolated.
+type ColorSpace int
+
+const (
+	// SpaceRGB interpolates the red, green and blue components.
+	// It is the simplest, but the colors in between tend to look
+	// dull.
+	SpaceRGB ColorSpace = iota
+
+	// SpaceHSL interpolates the hue, along the shortest way
+	// around the color wheel, the saturation and the lightness.
+	SpaceHSL
+
+	// SpaceOKLab interpolates in the [OKLab] color space, so the
+	// colors change at a perceptually even pace.
+	SpaceOKLab
+)
+
+// Gradient is a sequence of colors, the stops, evenly spread from
+// 0 to 1, which are interpolated in the given color space.
+type Gradient struct {
+	Stops []Color
+	Space ColorSpace
+}
+
+// At returns the color of the gradient at position t, in the range
+// 0-1, where 0 is the first stop and 1 is the last one. Positions
+// out of range are clamped. A gradient without stops is black.
+func (g Gradient) At(t float32) RGB {
+	n := len(g.Stops)
+	switch n {
+	case 0:
+		return RGB{}
+	case 1:
+		return RGBFromColor(g.Stops[0])
+	}
+
+	pos := _FClamp(0, t, 1) * float32(n-1)
+	i := min(int(pos), n-2)
+
+	return _Interpolate(g.Stops[i], g.Stops[i+1], pos-float32(i), g.Space)
+}
+
+// FGGradient returns s with its foreground colored by g, spread
+// over the characters of s, followed by a sequence to reset the
+// foreground color. Characters made of many runes, like some
+// emoji, take a single color. Escape sequences in s are kept.
+func FGGradient(s string, g Gradient) string {
+	return _Gradient(s, g, FGColor, UnFGColor())
+}
+
+// BGGradient returns s with its background colored by g, spread
+// over the characters of s, followed by a sequence to reset the
+// background color. Characters made of many runes, like some
+// emoji, take a single color. Escape sequences in s are kept.
+func BGGradient(s string, g Gradient) string {
+	return _Gradient(s, g, BGColor, UnBGColor())
+}
+
+func _Gradient(s string, g Gradient, color func(Color) string, reset string) string {
+	tokens := Parse(s)
+
+	var clusters int
+	for _, t := range tokens {
+		if t.Kind == TokenText {
+			for text := t.Raw; len(text) > 0; clusters++ {
+				n, _ := _NextCluster(text)
+				text = text[n:]
+			}
+		}
+	}
+
+	var buf strings.Builder
+	var last string
+	var colored bool
+	var i int
+
+	for _, t := range tokens {
+		if t.Kind != TokenText {
+			// the token may change the color, so it is set again
+			buf.WriteString(t.Raw)
+			last = ""
+			continue
+		}
+
+		for text := t.Raw; len(text) > 0; i++ {
+			var pos float32
+			if clusters > 1 {
+				pos = float32(i) / float32(clusters-1)
+			}
+
+			if seq := color(g.At(pos)); seq != last {
+				buf.WriteString(seq)
+				last = seq
+				colored = colored || seq != ""
+			}
+
+			n, _ := _NextCluster(text)
+			buf.WriteString(text[:n])
+			text = text[n:]
+		}
+	}
+
+	if colored {
+		buf.WriteString(reset)
+	}
+
+	return buf.String()
+}
+
+// _Interpolate returns the color at position t, in the range 0-1,
+// between a and b, interpolated in the given color space.
+func _Interpolate(a, b Color, t float32, space ColorSpace) RGB {
+	switch space {
+	case SpaceHSL:
+		x, y := HSLFromColor(a), HSLFromColor(b)
+
+		// grays have no hue, so they take the other's
+		if x.S == 0 {
+			x.H = y.H
+		} else if y.S == 0 {
+			y.H = x.H
+		}
+
+		dh := y.H - x.H
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+
+		return _RoundHSL(HSL{
+			x.H + dh*t,
+			_Lerp(x.S, y.S, t),
+			_Lerp(x.L, y.L, t),
+		})
+
+	case SpaceOKLab:
+		x, y := OKLabFromColor(a), OKLabFromColor(b)
+
+		return RGBFromColor(OKLab{
+			_Lerp(x.L, y.L, t),
+			_Lerp(x.A, y.A, t),
+			_Lerp(x.B, y.B, t),
+		})
+	}
+
+	x, y := RGBFromColor(a), RGBFromColor(b)
+
+	return RGB{
+		_Byte(_Lerp(float32(x.R), float32(y.R), t) / 255),
+		_Byte(_Lerp(float32(x.G), float32(y.G), t) / 255),
+		_Byte(_Lerp(float32(x.B), float32(y.B), t) / 255),
+	}
+}
+
+func _Lerp(a, b, t float32) float32 {
+	return a + (b-a)*t
+}
@@ -0,0 +1,273 @@
+package ansi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Render renders a string written in a small markup language into
+// a string with the equivalent escape sequences. Styles are opened
+// by tags in square brackets, which contain space separated words,
+// and closed by the tag [/]:
+//
+//	ansi.Render("[bold red]error:[/] no such file [link=file:///tmp/a]%s[/]", "a")
+//
+// The words of a tag may be:
+//
+//   - an attribute: bold, dim, italic, underline, blink,
+//     rapid_blink, reverse, conceal, strike, double_underline,
+//     curly_underline, dotted_underline, dashed_underline,
+//     overline, superscript or subscript;
+//   - a color, which sets the foreground, either a name of the
+//     basic 16 color palette, like red or bright_blue, or any
+//     color accepted by [ParseColor], like #F00, tomato or
+//     hsl(0, 100%, 50%);
+//   - the word on followed by a color, which sets the background;
+//   - link=<url>, which turns the text into a hyperlink.
+//
+// Tags can be nested, the inner tags inherit the styles of the
+// outer ones. A tag of the form [/<word>] closes the last open tag
+// whose first word is <word>, alongside every tag opened after it.
+// For links, <word> is link. A literal opening bracket is written
+// as \[. Invalid tags are rendered as is.
+//
+// If arguments are given, the rendered string is used as a format
+// for [fmt.Sprintf], so the arguments never have their brackets
+// interpreted as tags.
+func Render(markup string, a ...any) string {
+	type _Tag struct {
+		name  string
+		style Style
+	}
+
+	var buf Builder
+	var stack []_Tag
+	var current, emitted Style
+
+	buf.Grow(len(markup))
+
+	text := func(s string) {
+		if s == "" {
+			return
+		}
+
+		seq := Transition(emitted, current)
+		if len(a) > 0 {
+			seq = strings.ReplaceAll(seq, "%", "%%")
+		}
+
+		buf.WriteString(seq)
+		buf.WriteString(s)
+		emitted = current
+	}
+
+	for len(markup) > 0 {
+		i := strings.IndexAny(markup, "[\\")
+		if i < 0 {
+			text(markup)
+			break
+		}
+
+		text(markup[:i])
+		markup = markup[i:]
+
+		if markup[0] == '\\' {
+			if strings.HasPrefix(markup, "\\[") {
+				text("[")
+				markup = markup[2:]
+			} else {
+				text("\\")
+				markup = markup[1:]
+			}
+			continue
+		}
+
+		end := strings.IndexByte(markup, ']')
+		if end < 0 {
+			text(markup)
+			break
+		}
+
+		tag := strings.TrimSpace(markup[1:end])
+		raw := markup[:end+1]
+		markup = markup[end+1:]
+
+		if name, ok := strings.CutPrefix(tag, "/"); ok {
+			name = strings.TrimSpace(name)
+
+			found := len(stack) - 1
+			if name != "" {
+				for found >= 0 && stack[found].name != name {
+					found--
+				}
+			}
+
+			if found < 0 {
+				text(raw)
+				continue
+			}
+
+			stack = stack[:found]
+			current = Style{}
+			if len(stack) > 0 {
+				current = stack[len(stack)-1].style
+			}
+			continue
+		}
+
+		style, ok := _ParseTag(tag)
+		if !ok {
+			text(raw)
+			continue
+		}
+
+		current = style.Inherit(current)
+		stack = append(stack, _Tag{_TagName(tag), current})
+	}
+
+	current = Style{}
+	buf.WriteString(Transition(emitted, current))
+
+	if len(a) > 0 {
+		return fmt.Sprintf(buf.String(), a...)
+	}
+
+	return buf.String()
+}
+
+// _MarkupAttrs maps the attributes of the markup language of
+// [Render] to their styles.
+var _MarkupAttrs = map[string]Style{
+	"bold":             Style{}.Bold(),
+	"dim":              Style{}.Dim(),
+	"italic":           Style{}.Italic(),
+	"underline":        Style{}.Underline(),
+	"blink":            Style{}.Blink(),
+	"rapid_blink":      Style{}.RapidBlink(),
+	"reverse":          Style{}.Reverse(),
+	"conceal":          Style{}.Conceal(),
+	"strike":           Style{}.Strike(),
+	"double_underline": Style{}.DoubleUnderline(),
+	"curly_underline":  Style{}.StyleUnderline(UnderlineCurly),
+	"dotted_underline": Style{}.StyleUnderline(UnderlineDotted),
+	"dashed_underline": Style{}.StyleUnderline(UnderlineDashed),
+	"overline":         Style{}.Overline(),
+	"superscript":      Style{}.Superscript(),
+	"subscript":        Style{}.Subscript(),
+}
+
+// _ParseTag parses the content of an opening tag of the markup
+// language of [Render].
+func _ParseTag(tag string) (Style, bool) {
+	words := _TagWords(tag)
+	if len(words) == 0 {
+		return Style{}, false
+	}
+
+	var style Style
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+
+		if attr, ok := _MarkupAttrs[word]; ok {
+			style = attr.Inherit(style)
+			continue
+		}
+
+		if link, ok := strings.CutPrefix(word, "link="); ok && link != "" {
+			style = style.Link(link)
+			continue
+		}
+
+		if word == "on" && i+1 < len(words) {
+			c, ok := _ParseMarkupColor(words[i+1])
+			if !ok {
+				return Style{}, false
+			}
+
+			style = style.BG(c)
+			i++
+			continue
+		}
+
+		c, ok := _ParseMarkupColor(word)
+		if !ok {
+			return Style{}, false
+		}
+
+		style = style.FG(c)
+	}
+
+	return style, true
+}
+
+// _TagName returns the name by which a tag can be closed, that is,
+// its first word, without any value.
+func _TagName(tag string) string {
+	name := _TagWords(tag)[0]
+	name, _, _ = strings.Cut(name, "=")
+	return name
+}
+
+// _TagWords splits the tag in space separated words, ignoring the
+// spaces inside parentheses.
+func _TagWords(tag string) []string {
+	var words []string
+
+	depth, start := 0, -1
+	for i, r := range tag {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth = max(depth-1, 0)
+		case r == ' ' && depth == 0:
+			if start >= 0 {
+				words = append(words, tag[start:i])
+				start = -1
+			}
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		words = append(words, tag[start:])
+	}
+
+	return words
+}
+
+var _MarkupColors = map[string]ANSI16{
+	"black":          Black,
+	"red":            Red,
+	"green":          Green,
+	"yellow":         Yellow,
+	"blue":           Blue,
+	"magenta":        Magenta,
+	"cyan":           Cyan,
+	"white":          White,
+	"bright_black":   BrightBlack,
+	"bright_red":     BrightRed,
+	"bright_green":   BrightGreen,
+	"bright_yellow":  BrightYellow,
+	"bright_blue":    BrightBlue,
+	"bright_magenta": BrightMagenta,
+	"bright_cyan":    BrightCyan,
+	"bright_white":   BrightWhite,
+}
+
+// _ParseMarkupColor parses a color of the markup language of
+// [Render]. The names of the basic 16 color palette take
+// precedence over the named colors of [ParseColor], so they
+// respect the terminal's theme.
+func _ParseMarkupColor(s string) (Color, bool) {
+	if c, ok := _MarkupColors[strings.ToLower(s)]; ok {
+		return c, true
+	}
+
+	c, err := ParseColor(s)
+	return c, err == nil
+}
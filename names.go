@@ -0,0 +1,867 @@
+package ansi
+
+import (
+	"strings"
+)
+
+// CSSColor returns the color of the given name, as defined by
+// the CSS Color Module Level 4. Names are case insensitive, and
+// spaces, underscores and hyphens are ignored, so "Dark Slate
+// Gray" and "dark_slate_gray" both name darkslategray.
+func CSSColor(name string) (RGB, bool) {
+	c, ok := _CSSColors[_NormalizeName(name)]
+	return c, ok
+}
+
+// X11Color returns the color of the given name, as defined by
+// the X11 rgb.txt file, including the numbered variants, like
+// "gray50" and "SteelBlue3". Names are normalized as in
+// [CSSColor]. Note that some colors, like gray, green, maroon and
+// purple, differ from their CSS counterparts.
+func X11Color(name string) (RGB, bool) {
+	c, ok := _X11Colors[_NormalizeName(name)]
+	return c, ok
+}
+
+// NamedColor returns the color of the given name, looking it up
+// first as a CSS color, see [CSSColor], and then as a X11 color,
+// see [X11Color].
+func NamedColor(name string) (RGB, bool) {
+	name = _NormalizeName(name)
+
+	if c, ok := _CSSColors[name]; ok {
+		return c, true
+	}
+
+	c, ok := _X11Colors[name]
+	return c, ok
+}
+
+// _NormalizeName lowers the case of name and removes any spaces,
+// underscores and hyphens from it.
+func _NormalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-':
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(name))
+}
+
+// _CSSColors holds the named colors of the CSS Color Module
+// Level 4.
+var _CSSColors = map[string]RGB{
+	"aliceblue":            {0xF0, 0xF8, 0xFF},
+	"antiquewhite":         {0xFA, 0xEB, 0xD7},
+	"aqua":                 {0x00, 0xFF, 0xFF},
+	"aquamarine":           {0x7F, 0xFF, 0xD4},
+	"azure":                {0xF0, 0xFF, 0xFF},
+	"beige":                {0xF5, 0xF5, 0xDC},
+	"bisque":               {0xFF, 0xE4, 0xC4},
+	"black":                {0x00, 0x00, 0x00},
+	"blanchedalmond":       {0xFF, 0xEB, 0xCD},
+	"blue":                 {0x00, 0x00, 0xFF},
+	"blueviolet":           {0x8A, 0x2B, 0xE2},
+	"brown":                {0xA5, 0x2A, 0x2A},
+	"burlywood":            {0xDE, 0xB8, 0x87},
+	"cadetblue":            {0x5F, 0x9E, 0xA0},
+	"chartreuse":           {0x7F, 0xFF, 0x00},
+	"chocolate":            {0xD2, 0x69, 0x1E},
+	"coral":                {0xFF, 0x7F, 0x50},
+	"cornflowerblue":       {0x64, 0x95, 0xED},
+	"cornsilk":             {0xFF, 0xF8, 0xDC},
+	"crimson":              {0xDC, 0x14, 0x3C},
+	"cyan":                 {0x00, 0xFF, 0xFF},
+	"darkblue":             {0x00, 0x00, 0x8B},
+	"darkcyan":             {0x00, 0x8B, 0x8B},
+	"darkgoldenrod":        {0xB8, 0x86, 0x0B},
+	"darkgray":             {0xA9, 0xA9, 0xA9},
+	"darkgreen":            {0x00, 0x64, 0x00},
+	"darkgrey":             {0xA9, 0xA9, 0xA9},
+	"darkkhaki":            {0xBD, 0xB7, 0x6B},
+	"darkmagenta":          {0x8B, 0x00, 0x8B},
+	"darkolivegreen":       {0x55, 0x6B, 0x2F},
+	"darkorange":           {0xFF, 0x8C, 0x00},
+	"darkorchid":           {0x99, 0x32, 0xCC},
+	"darkred":              {0x8B, 0x00, 0x00},
+	"darksalmon":           {0xE9, 0x96, 0x7A},
+	"darkseagreen":         {0x8F, 0xBC, 0x8F},
+	"darkslateblue":        {0x48, 0x3D, 0x8B},
+	"darkslategray":        {0x2F, 0x4F, 0x4F},
+	"darkslategrey":        {0x2F, 0x4F, 0x4F},
+	"darkturquoise":        {0x00, 0xCE, 0xD1},
+	"darkviolet":           {0x94, 0x00, 0xD3},
+	"deeppink":             {0xFF, 0x14, 0x93},
+	"deepskyblue":          {0x00, 0xBF, 0xFF},
+	"dimgray":              {0x69, 0x69, 0x69},
+	"dimgrey":              {0x69, 0x69, 0x69},
+	"dodgerblue":           {0x1E, 0x90, 0xFF},
+	"firebrick":            {0xB2, 0x22, 0x22},
+	"floralwhite":          {0xFF, 0xFA, 0xF0},
+	"forestgreen":          {0x22, 0x8B, 0x22},
+	"fuchsia":              {0xFF, 0x00, 0xFF},
+	"gainsboro":            {0xDC, 0xDC, 0xDC},
+	"ghostwhite":           {0xF8, 0xF8, 0xFF},
+	"gold":                 {0xFF, 0xD7, 0x00},
+	"goldenrod":            {0xDA, 0xA5, 0x20},
+	"gray":                 {0x80, 0x80, 0x80},
+	"green":                {0x00, 0x80, 0x00},
+	"greenyellow":          {0xAD, 0xFF, 0x2F},
+	"grey":                 {0x80, 0x80, 0x80},
+	"honeydew":             {0xF0, 0xFF, 0xF0},
+	"hotpink":              {0xFF, 0x69, 0xB4},
+	"indianred":            {0xCD, 0x5C, 0x5C},
+	"indigo":               {0x4B, 0x00, 0x82},
+	"ivory":                {0xFF, 0xFF, 0xF0},
+	"khaki":                {0xF0, 0xE6, 0x8C},
+	"lavender":             {0xE6, 0xE6, 0xFA},
+	"lavenderblush":        {0xFF, 0xF0, 0xF5},
+	"lawngreen":            {0x7C, 0xFC, 0x00},
+	"lemonchiffon":         {0xFF, 0xFA, 0xCD},
+	"lightblue":            {0xAD, 0xD8, 0xE6},
+	"lightcoral":           {0xF0, 0x80, 0x80},
+	"lightcyan":            {0xE0, 0xFF, 0xFF},
+	"lightgoldenrodyellow": {0xFA, 0xFA, 0xD2},
+	"lightgray":            {0xD3, 0xD3, 0xD3},
+	"lightgreen":           {0x90, 0xEE, 0x90},
+	"lightgrey":            {0xD3, 0xD3, 0xD3},
+	"lightpink":            {0xFF, 0xB6, 0xC1},
+	"lightsalmon":          {0xFF, 0xA0, 0x7A},
+	"lightseagreen":        {0x20, 0xB2, 0xAA},
+	"lightskyblue":         {0x87, 0xCE, 0xFA},
+	"lightslategray":       {0x77, 0x88, 0x99},
+	"lightslategrey":       {0x77, 0x88, 0x99},
+	"lightsteelblue":       {0xB0, 0xC4, 0xDE},
+	"lightyellow":          {0xFF, 0xFF, 0xE0},
+	"lime":                 {0x00, 0xFF, 0x00},
+	"limegreen":            {0x32, 0xCD, 0x32},
+	"linen":                {0xFA, 0xF0, 0xE6},
+	"magenta":              {0xFF, 0x00, 0xFF},
+	"maroon":               {0x80, 0x00, 0x00},
+	"mediumaquamarine":     {0x66, 0xCD, 0xAA},
+	"mediumblue":           {0x00, 0x00, 0xCD},
+	"mediumorchid":         {0xBA, 0x55, 0xD3},
+	"mediumpurple":         {0x93, 0x70, 0xDB},
+	"mediumseagreen":       {0x3C, 0xB3, 0x71},
+	"mediumslateblue":      {0x7B, 0x68, 0xEE},
+	"mediumspringgreen":    {0x00, 0xFA, 0x9A},
+	"mediumturquoise":      {0x48, 0xD1, 0xCC},
+	"mediumvioletred":      {0xC7, 0x15, 0x85},
+	"midnightblue":         {0x19, 0x19, 0x70},
+	"mintcream":            {0xF5, 0xFF, 0xFA},
+	"mistyrose":            {0xFF, 0xE4, 0xE1},
+	"moccasin":             {0xFF, 0xE4, 0xB5},
+	"navajowhite":          {0xFF, 0xDE, 0xAD},
+	"navy":                 {0x00, 0x00, 0x80},
+	"oldlace":              {0xFD, 0xF5, 0xE6},
+	"olive":                {0x80, 0x80, 0x00},
+	"olivedrab":            {0x6B, 0x8E, 0x23},
+	"orange":               {0xFF, 0xA5, 0x00},
+	"orangered":            {0xFF, 0x45, 0x00},
+	"orchid":               {0xDA, 0x70, 0xD6},
+	"palegoldenrod":        {0xEE, 0xE8, 0xAA},
+	"palegreen":            {0x98, 0xFB, 0x98},
+	"paleturquoise":        {0xAF, 0xEE, 0xEE},
+	"palevioletred":        {0xDB, 0x70, 0x93},
+	"papayawhip":           {0xFF, 0xEF, 0xD5},
+	"peachpuff":            {0xFF, 0xDA, 0xB9},
+	"peru":                 {0xCD, 0x85, 0x3F},
+	"pink":                 {0xFF, 0xC0, 0xCB},
+	"plum":                 {0xDD, 0xA0, 0xDD},
+	"powderblue":           {0xB0, 0xE0, 0xE6},
+	"purple":               {0x80, 0x00, 0x80},
+	"rebeccapurple":        {0x66, 0x33, 0x99},
+	"red":                  {0xFF, 0x00, 0x00},
+	"rosybrown":            {0xBC, 0x8F, 0x8F},
+	"royalblue":            {0x41, 0x69, 0xE1},
+	"saddlebrown":          {0x8B, 0x45, 0x13},
+	"salmon":               {0xFA, 0x80, 0x72},
+	"sandybrown":           {0xF4, 0xA4, 0x60},
+	"seagreen":             {0x2E, 0x8B, 0x57},
+	"seashell":             {0xFF, 0xF5, 0xEE},
+	"sienna":               {0xA0, 0x52, 0x2D},
+	"silver":               {0xC0, 0xC0, 0xC0},
+	"skyblue":              {0x87, 0xCE, 0xEB},
+	"slateblue":            {0x6A, 0x5A, 0xCD},
+	"slategray":            {0x70, 0x80, 0x90},
+	"slategrey":            {0x70, 0x80, 0x90},
+	"snow":                 {0xFF, 0xFA, 0xFA},
+	"springgreen":          {0x00, 0xFF, 0x7F},
+	"steelblue":            {0x46, 0x82, 0xB4},
+	"tan":                  {0xD2, 0xB4, 0x8C},
+	"teal":                 {0x00, 0x80, 0x80},
+	"thistle":              {0xD8, 0xBF, 0xD8},
+	"tomato":               {0xFF, 0x63, 0x47},
+	"turquoise":            {0x40, 0xE0, 0xD0},
+	"violet":               {0xEE, 0x82, 0xEE},
+	"wheat":                {0xF5, 0xDE, 0xB3},
+	"white":                {0xFF, 0xFF, 0xFF},
+	"whitesmoke":           {0xF5, 0xF5, 0xF5},
+	"yellow":               {0xFF, 0xFF, 0x00},
+	"yellowgreen":          {0x9A, 0xCD, 0x32},
+}
+
+// _X11Colors holds the named colors of the X11 rgb.txt file, with
+// their names normalized.
+var _X11Colors = map[string]RGB{
+	"aliceblue":            {0xF0, 0xF8, 0xFF},
+	"antiquewhite":         {0xFA, 0xEB, 0xD7},
+	"antiquewhite1":        {0xFF, 0xEF, 0xDB},
+	"antiquewhite2":        {0xEE, 0xDF, 0xCC},
+	"antiquewhite3":        {0xCD, 0xC0, 0xB0},
+	"antiquewhite4":        {0x8B, 0x83, 0x78},
+	"aquamarine":           {0x7F, 0xFF, 0xD4},
+	"aquamarine1":          {0x7F, 0xFF, 0xD4},
+	"aquamarine2":          {0x76, 0xEE, 0xC6},
+	"aquamarine3":          {0x66, 0xCD, 0xAA},
+	"aquamarine4":          {0x45, 0x8B, 0x74},
+	"azure":                {0xF0, 0xFF, 0xFF},
+	"azure1":               {0xF0, 0xFF, 0xFF},
+	"azure2":               {0xE0, 0xEE, 0xEE},
+	"azure3":               {0xC1, 0xCD, 0xCD},
+	"azure4":               {0x83, 0x8B, 0x8B},
+	"beige":                {0xF5, 0xF5, 0xDC},
+	"bisque":               {0xFF, 0xE4, 0xC4},
+	"bisque1":              {0xFF, 0xE4, 0xC4},
+	"bisque2":              {0xEE, 0xD5, 0xB7},
+	"bisque3":              {0xCD, 0xB7, 0x9E},
+	"bisque4":              {0x8B, 0x7D, 0x6B},
+	"black":                {0x00, 0x00, 0x00},
+	"blanchedalmond":       {0xFF, 0xEB, 0xCD},
+	"blue":                 {0x00, 0x00, 0xFF},
+	"blue1":                {0x00, 0x00, 0xFF},
+	"blue2":                {0x00, 0x00, 0xEE},
+	"blue3":                {0x00, 0x00, 0xCD},
+	"blue4":                {0x00, 0x00, 0x8B},
+	"blueviolet":           {0x8A, 0x2B, 0xE2},
+	"brown":                {0xA5, 0x2A, 0x2A},
+	"brown1":               {0xFF, 0x40, 0x40},
+	"brown2":               {0xEE, 0x3B, 0x3B},
+	"brown3":               {0xCD, 0x33, 0x33},
+	"brown4":               {0x8B, 0x23, 0x23},
+	"burlywood":            {0xDE, 0xB8, 0x87},
+	"burlywood1":           {0xFF, 0xD3, 0x9B},
+	"burlywood2":           {0xEE, 0xC5, 0x91},
+	"burlywood3":           {0xCD, 0xAA, 0x7D},
+	"burlywood4":           {0x8B, 0x73, 0x55},
+	"cadetblue":            {0x5F, 0x9E, 0xA0},
+	"cadetblue1":           {0x98, 0xF5, 0xFF},
+	"cadetblue2":           {0x8E, 0xE5, 0xEE},
+	"cadetblue3":           {0x7A, 0xC5, 0xCD},
+	"cadetblue4":           {0x53, 0x86, 0x8B},
+	"chartreuse":           {0x7F, 0xFF, 0x00},
+	"chartreuse1":          {0x7F, 0xFF, 0x00},
+	"chartreuse2":          {0x76, 0xEE, 0x00},
+	"chartreuse3":          {0x66, 0xCD, 0x00},
+	"chartreuse4":          {0x45, 0x8B, 0x00},
+	"chocolate":            {0xD2, 0x69, 0x1E},
+	"chocolate1":           {0xFF, 0x7F, 0x24},
+	"chocolate2":           {0xEE, 0x76, 0x21},
+	"chocolate3":           {0xCD, 0x66, 0x1D},
+	"chocolate4":           {0x8B, 0x45, 0x13},
+	"coral":                {0xFF, 0x7F, 0x50},
+	"coral1":               {0xFF, 0x72, 0x56},
+	"coral2":               {0xEE, 0x6A, 0x50},
+	"coral3":               {0xCD, 0x5B, 0x45},
+	"coral4":               {0x8B, 0x3E, 0x2F},
+	"cornflowerblue":       {0x64, 0x95, 0xED},
+	"cornsilk":             {0xFF, 0xF8, 0xDC},
+	"cornsilk1":            {0xFF, 0xF8, 0xDC},
+	"cornsilk2":            {0xEE, 0xE8, 0xCD},
+	"cornsilk3":            {0xCD, 0xC8, 0xB1},
+	"cornsilk4":            {0x8B, 0x88, 0x78},
+	"cyan":                 {0x00, 0xFF, 0xFF},
+	"cyan1":                {0x00, 0xFF, 0xFF},
+	"cyan2":                {0x00, 0xEE, 0xEE},
+	"cyan3":                {0x00, 0xCD, 0xCD},
+	"cyan4":                {0x00, 0x8B, 0x8B},
+	"darkblue":             {0x00, 0x00, 0x8B},
+	"darkcyan":             {0x00, 0x8B, 0x8B},
+	"darkgoldenrod":        {0xB8, 0x86, 0x0B},
+	"darkgoldenrod1":       {0xFF, 0xB9, 0x0F},
+	"darkgoldenrod2":       {0xEE, 0xAD, 0x0E},
+	"darkgoldenrod3":       {0xCD, 0x95, 0x0C},
+	"darkgoldenrod4":       {0x8B, 0x65, 0x08},
+	"darkgray":             {0xA9, 0xA9, 0xA9},
+	"darkgreen":            {0x00, 0x64, 0x00},
+	"darkgrey":             {0xA9, 0xA9, 0xA9},
+	"darkkhaki":            {0xBD, 0xB7, 0x6B},
+	"darkmagenta":          {0x8B, 0x00, 0x8B},
+	"darkolivegreen":       {0x55, 0x6B, 0x2F},
+	"darkolivegreen1":      {0xCA, 0xFF, 0x70},
+	"darkolivegreen2":      {0xBC, 0xEE, 0x68},
+	"darkolivegreen3":      {0xA2, 0xCD, 0x5A},
+	"darkolivegreen4":      {0x6E, 0x8B, 0x3D},
+	"darkorange":           {0xFF, 0x8C, 0x00},
+	"darkorange1":          {0xFF, 0x7F, 0x00},
+	"darkorange2":          {0xEE, 0x76, 0x00},
+	"darkorange3":          {0xCD, 0x66, 0x00},
+	"darkorange4":          {0x8B, 0x45, 0x00},
+	"darkorchid":           {0x99, 0x32, 0xCC},
+	"darkorchid1":          {0xBF, 0x3E, 0xFF},
+	"darkorchid2":          {0xB2, 0x3A, 0xEE},
+	"darkorchid3":          {0x9A, 0x32, 0xCD},
+	"darkorchid4":          {0x68, 0x22, 0x8B},
+	"darkred":              {0x8B, 0x00, 0x00},
+	"darksalmon":           {0xE9, 0x96, 0x7A},
+	"darkseagreen":         {0x8F, 0xBC, 0x8F},
+	"darkseagreen1":        {0xC1, 0xFF, 0xC1},
+	"darkseagreen2":        {0xB4, 0xEE, 0xB4},
+	"darkseagreen3":        {0x9B, 0xCD, 0x9B},
+	"darkseagreen4":        {0x69, 0x8B, 0x69},
+	"darkslateblue":        {0x48, 0x3D, 0x8B},
+	"darkslategray":        {0x2F, 0x4F, 0x4F},
+	"darkslategray1":       {0x97, 0xFF, 0xFF},
+	"darkslategray2":       {0x8D, 0xEE, 0xEE},
+	"darkslategray3":       {0x79, 0xCD, 0xCD},
+	"darkslategray4":       {0x52, 0x8B, 0x8B},
+	"darkslategrey":        {0x2F, 0x4F, 0x4F},
+	"darkturquoise":        {0x00, 0xCE, 0xD1},
+	"darkviolet":           {0x94, 0x00, 0xD3},
+	"debianred":            {0xD7, 0x07, 0x51},
+	"deeppink":             {0xFF, 0x14, 0x93},
+	"deeppink1":            {0xFF, 0x14, 0x93},
+	"deeppink2":            {0xEE, 0x12, 0x89},
+	"deeppink3":            {0xCD, 0x10, 0x76},
+	"deeppink4":            {0x8B, 0x0A, 0x50},
+	"deepskyblue":          {0x00, 0xBF, 0xFF},
+	"deepskyblue1":         {0x00, 0xBF, 0xFF},
+	"deepskyblue2":         {0x00, 0xB2, 0xEE},
+	"deepskyblue3":         {0x00, 0x9A, 0xCD},
+	"deepskyblue4":         {0x00, 0x68, 0x8B},
+	"dimgray":              {0x69, 0x69, 0x69},
+	"dimgrey":              {0x69, 0x69, 0x69},
+	"dodgerblue":           {0x1E, 0x90, 0xFF},
+	"dodgerblue1":          {0x1E, 0x90, 0xFF},
+	"dodgerblue2":          {0x1C, 0x86, 0xEE},
+	"dodgerblue3":          {0x18, 0x74, 0xCD},
+	"dodgerblue4":          {0x10, 0x4E, 0x8B},
+	"firebrick":            {0xB2, 0x22, 0x22},
+	"firebrick1":           {0xFF, 0x30, 0x30},
+	"firebrick2":           {0xEE, 0x2C, 0x2C},
+	"firebrick3":           {0xCD, 0x26, 0x26},
+	"firebrick4":           {0x8B, 0x1A, 0x1A},
+	"floralwhite":          {0xFF, 0xFA, 0xF0},
+	"forestgreen":          {0x22, 0x8B, 0x22},
+	"gainsboro":            {0xDC, 0xDC, 0xDC},
+	"ghostwhite":           {0xF8, 0xF8, 0xFF},
+	"gold":                 {0xFF, 0xD7, 0x00},
+	"gold1":                {0xFF, 0xD7, 0x00},
+	"gold2":                {0xEE, 0xC9, 0x00},
+	"gold3":                {0xCD, 0xAD, 0x00},
+	"gold4":                {0x8B, 0x75, 0x00},
+	"goldenrod":            {0xDA, 0xA5, 0x20},
+	"goldenrod1":           {0xFF, 0xC1, 0x25},
+	"goldenrod2":           {0xEE, 0xB4, 0x22},
+	"goldenrod3":           {0xCD, 0x9B, 0x1D},
+	"goldenrod4":           {0x8B, 0x69, 0x14},
+	"gray":                 {0xBE, 0xBE, 0xBE},
+	"gray0":                {0x00, 0x00, 0x00},
+	"gray1":                {0x03, 0x03, 0x03},
+	"gray10":               {0x1A, 0x1A, 0x1A},
+	"gray100":              {0xFF, 0xFF, 0xFF},
+	"gray11":               {0x1C, 0x1C, 0x1C},
+	"gray12":               {0x1F, 0x1F, 0x1F},
+	"gray13":               {0x21, 0x21, 0x21},
+	"gray14":               {0x24, 0x24, 0x24},
+	"gray15":               {0x26, 0x26, 0x26},
+	"gray16":               {0x29, 0x29, 0x29},
+	"gray17":               {0x2B, 0x2B, 0x2B},
+	"gray18":               {0x2E, 0x2E, 0x2E},
+	"gray19":               {0x30, 0x30, 0x30},
+	"gray2":                {0x05, 0x05, 0x05},
+	"gray20":               {0x33, 0x33, 0x33},
+	"gray21":               {0x36, 0x36, 0x36},
+	"gray22":               {0x38, 0x38, 0x38},
+	"gray23":               {0x3B, 0x3B, 0x3B},
+	"gray24":               {0x3D, 0x3D, 0x3D},
+	"gray25":               {0x40, 0x40, 0x40},
+	"gray26":               {0x42, 0x42, 0x42},
+	"gray27":               {0x45, 0x45, 0x45},
+	"gray28":               {0x47, 0x47, 0x47},
+	"gray29":               {0x4A, 0x4A, 0x4A},
+	"gray3":                {0x08, 0x08, 0x08},
+	"gray30":               {0x4D, 0x4D, 0x4D},
+	"gray31":               {0x4F, 0x4F, 0x4F},
+	"gray32":               {0x52, 0x52, 0x52},
+	"gray33":               {0x54, 0x54, 0x54},
+	"gray34":               {0x57, 0x57, 0x57},
+	"gray35":               {0x59, 0x59, 0x59},
+	"gray36":               {0x5C, 0x5C, 0x5C},
+	"gray37":               {0x5E, 0x5E, 0x5E},
+	"gray38":               {0x61, 0x61, 0x61},
+	"gray39":               {0x63, 0x63, 0x63},
+	"gray4":                {0x0A, 0x0A, 0x0A},
+	"gray40":               {0x66, 0x66, 0x66},
+	"gray41":               {0x69, 0x69, 0x69},
+	"gray42":               {0x6B, 0x6B, 0x6B},
+	"gray43":               {0x6E, 0x6E, 0x6E},
+	"gray44":               {0x70, 0x70, 0x70},
+	"gray45":               {0x73, 0x73, 0x73},
+	"gray46":               {0x75, 0x75, 0x75},
+	"gray47":               {0x78, 0x78, 0x78},
+	"gray48":               {0x7A, 0x7A, 0x7A},
+	"gray49":               {0x7D, 0x7D, 0x7D},
+	"gray5":                {0x0D, 0x0D, 0x0D},
+	"gray50":               {0x7F, 0x7F, 0x7F},
+	"gray51":               {0x82, 0x82, 0x82},
+	"gray52":               {0x85, 0x85, 0x85},
+	"gray53":               {0x87, 0x87, 0x87},
+	"gray54":               {0x8A, 0x8A, 0x8A},
+	"gray55":               {0x8C, 0x8C, 0x8C},
+	"gray56":               {0x8F, 0x8F, 0x8F},
+	"gray57":               {0x91, 0x91, 0x91},
+	"gray58":               {0x94, 0x94, 0x94},
+	"gray59":               {0x96, 0x96, 0x96},
+	"gray6":                {0x0F, 0x0F, 0x0F},
+	"gray60":               {0x99, 0x99, 0x99},
+	"gray61":               {0x9C, 0x9C, 0x9C},
+	"gray62":               {0x9E, 0x9E, 0x9E},
+	"gray63":               {0xA1, 0xA1, 0xA1},
+	"gray64":               {0xA3, 0xA3, 0xA3},
+	"gray65":               {0xA6, 0xA6, 0xA6},
+	"gray66":               {0xA8, 0xA8, 0xA8},
+	"gray67":               {0xAB, 0xAB, 0xAB},
+	"gray68":               {0xAD, 0xAD, 0xAD},
+	"gray69":               {0xB0, 0xB0, 0xB0},
+	"gray7":                {0x12, 0x12, 0x12},
+	"gray70":               {0xB3, 0xB3, 0xB3},
+	"gray71":               {0xB5, 0xB5, 0xB5},
+	"gray72":               {0xB8, 0xB8, 0xB8},
+	"gray73":               {0xBA, 0xBA, 0xBA},
+	"gray74":               {0xBD, 0xBD, 0xBD},
+	"gray75":               {0xBF, 0xBF, 0xBF},
+	"gray76":               {0xC2, 0xC2, 0xC2},
+	"gray77":               {0xC4, 0xC4, 0xC4},
+	"gray78":               {0xC7, 0xC7, 0xC7},
+	"gray79":               {0xC9, 0xC9, 0xC9},
+	"gray8":                {0x14, 0x14, 0x14},
+	"gray80":               {0xCC, 0xCC, 0xCC},
+	"gray81":               {0xCF, 0xCF, 0xCF},
+	"gray82":               {0xD1, 0xD1, 0xD1},
+	"gray83":               {0xD4, 0xD4, 0xD4},
+	"gray84":               {0xD6, 0xD6, 0xD6},
+	"gray85":               {0xD9, 0xD9, 0xD9},
+	"gray86":               {0xDB, 0xDB, 0xDB},
+	"gray87":               {0xDE, 0xDE, 0xDE},
+	"gray88":               {0xE0, 0xE0, 0xE0},
+	"gray89":               {0xE3, 0xE3, 0xE3},
+	"gray9":                {0x17, 0x17, 0x17},
+	"gray90":               {0xE5, 0xE5, 0xE5},
+	"gray91":               {0xE8, 0xE8, 0xE8},
+	"gray92":               {0xEB, 0xEB, 0xEB},
+	"gray93":               {0xED, 0xED, 0xED},
+	"gray94":               {0xF0, 0xF0, 0xF0},
+	"gray95":               {0xF2, 0xF2, 0xF2},
+	"gray96":               {0xF5, 0xF5, 0xF5},
+	"gray97":               {0xF7, 0xF7, 0xF7},
+	"gray98":               {0xFA, 0xFA, 0xFA},
+	"gray99":               {0xFC, 0xFC, 0xFC},
+	"green":                {0x00, 0xFF, 0x00},
+	"green1":               {0x00, 0xFF, 0x00},
+	"green2":               {0x00, 0xEE, 0x00},
+	"green3":               {0x00, 0xCD, 0x00},
+	"green4":               {0x00, 0x8B, 0x00},
+	"greenyellow":          {0xAD, 0xFF, 0x2F},
+	"grey":                 {0xBE, 0xBE, 0xBE},
+	"grey0":                {0x00, 0x00, 0x00},
+	"grey1":                {0x03, 0x03, 0x03},
+	"grey10":               {0x1A, 0x1A, 0x1A},
+	"grey100":              {0xFF, 0xFF, 0xFF},
+	"grey11":               {0x1C, 0x1C, 0x1C},
+	"grey12":               {0x1F, 0x1F, 0x1F},
+	"grey13":               {0x21, 0x21, 0x21},
+	"grey14":               {0x24, 0x24, 0x24},
+	"grey15":               {0x26, 0x26, 0x26},
+	"grey16":               {0x29, 0x29, 0x29},
+	"grey17":               {0x2B, 0x2B, 0x2B},
+	"grey18":               {0x2E, 0x2E, 0x2E},
+	"grey19":               {0x30, 0x30, 0x30},
+	"grey2":                {0x05, 0x05, 0x05},
+	"grey20":               {0x33, 0x33, 0x33},
+	"grey21":               {0x36, 0x36, 0x36},
+	"grey22":               {0x38, 0x38, 0x38},
+	"grey23":               {0x3B, 0x3B, 0x3B},
+	"grey24":               {0x3D, 0x3D, 0x3D},
+	"grey25":               {0x40, 0x40, 0x40},
+	"grey26":               {0x42, 0x42, 0x42},
+	"grey27":               {0x45, 0x45, 0x45},
+	"grey28":               {0x47, 0x47, 0x47},
+	"grey29":               {0x4A, 0x4A, 0x4A},
+	"grey3":                {0x08, 0x08, 0x08},
+	"grey30":               {0x4D, 0x4D, 0x4D},
+	"grey31":               {0x4F, 0x4F, 0x4F},
+	"grey32":               {0x52, 0x52, 0x52},
+	"grey33":               {0x54, 0x54, 0x54},
+	"grey34":               {0x57, 0x57, 0x57},
+	"grey35":               {0x59, 0x59, 0x59},
+	"grey36":               {0x5C, 0x5C, 0x5C},
+	"grey37":               {0x5E, 0x5E, 0x5E},
+	"grey38":               {0x61, 0x61, 0x61},
+	"grey39":               {0x63, 0x63, 0x63},
+	"grey4":                {0x0A, 0x0A, 0x0A},
+	"grey40":               {0x66, 0x66, 0x66},
+	"grey41":               {0x69, 0x69, 0x69},
+	"grey42":               {0x6B, 0x6B, 0x6B},
+	"grey43":               {0x6E, 0x6E, 0x6E},
+	"grey44":               {0x70, 0x70, 0x70},
+	"grey45":               {0x73, 0x73, 0x73},
+	"grey46":               {0x75, 0x75, 0x75},
+	"grey47":               {0x78, 0x78, 0x78},
+	"grey48":               {0x7A, 0x7A, 0x7A},
+	"grey49":               {0x7D, 0x7D, 0x7D},
+	"grey5":                {0x0D, 0x0D, 0x0D},
+	"grey50":               {0x7F, 0x7F, 0x7F},
+	"grey51":               {0x82, 0x82, 0x82},
+	"grey52":               {0x85, 0x85, 0x85},
+	"grey53":               {0x87, 0x87, 0x87},
+	"grey54":               {0x8A, 0x8A, 0x8A},
+	"grey55":               {0x8C, 0x8C, 0x8C},
+	"grey56":               {0x8F, 0x8F, 0x8F},
+	"grey57":               {0x91, 0x91, 0x91},
+	"grey58":               {0x94, 0x94, 0x94},
+	"grey59":               {0x96, 0x96, 0x96},
+	"grey6":                {0x0F, 0x0F, 0x0F},
+	"grey60":               {0x99, 0x99, 0x99},
+	"grey61":               {0x9C, 0x9C, 0x9C},
+	"grey62":               {0x9E, 0x9E, 0x9E},
+	"grey63":               {0xA1, 0xA1, 0xA1},
+	"grey64":               {0xA3, 0xA3, 0xA3},
+	"grey65":               {0xA6, 0xA6, 0xA6},
+	"grey66":               {0xA8, 0xA8, 0xA8},
+	"grey67":               {0xAB, 0xAB, 0xAB},
+	"grey68":               {0xAD, 0xAD, 0xAD},
+	"grey69":               {0xB0, 0xB0, 0xB0},
+	"grey7":                {0x12, 0x12, 0x12},
+	"grey70":               {0xB3, 0xB3, 0xB3},
+	"grey71":               {0xB5, 0xB5, 0xB5},
+	"grey72":               {0xB8, 0xB8, 0xB8},
+	"grey73":               {0xBA, 0xBA, 0xBA},
+	"grey74":               {0xBD, 0xBD, 0xBD},
+	"grey75":               {0xBF, 0xBF, 0xBF},
+	"grey76":               {0xC2, 0xC2, 0xC2},
+	"grey77":               {0xC4, 0xC4, 0xC4},
+	"grey78":               {0xC7, 0xC7, 0xC7},
+	"grey79":               {0xC9, 0xC9, 0xC9},
+	"grey8":                {0x14, 0x14, 0x14},
+	"grey80":               {0xCC, 0xCC, 0xCC},
+	"grey81":               {0xCF, 0xCF, 0xCF},
+	"grey82":               {0xD1, 0xD1, 0xD1},
+	"grey83":               {0xD4, 0xD4, 0xD4},
+	"grey84":               {0xD6, 0xD6, 0xD6},
+	"grey85":               {0xD9, 0xD9, 0xD9},
+	"grey86":               {0xDB, 0xDB, 0xDB},
+	"grey87":               {0xDE, 0xDE, 0xDE},
+	"grey88":               {0xE0, 0xE0, 0xE0},
+	"grey89":               {0xE3, 0xE3, 0xE3},
+	"grey9":                {0x17, 0x17, 0x17},
+	"grey90":               {0xE5, 0xE5, 0xE5},
+	"grey91":               {0xE8, 0xE8, 0xE8},
+	"grey92":               {0xEB, 0xEB, 0xEB},
+	"grey93":               {0xED, 0xED, 0xED},
+	"grey94":               {0xF0, 0xF0, 0xF0},
+	"grey95":               {0xF2, 0xF2, 0xF2},
+	"grey96":               {0xF5, 0xF5, 0xF5},
+	"grey97":               {0xF7, 0xF7, 0xF7},
+	"grey98":               {0xFA, 0xFA, 0xFA},
+	"grey99":               {0xFC, 0xFC, 0xFC},
+	"honeydew":             {0xF0, 0xFF, 0xF0},
+	"honeydew1":            {0xF0, 0xFF, 0xF0},
+	"honeydew2":            {0xE0, 0xEE, 0xE0},
+	"honeydew3":            {0xC1, 0xCD, 0xC1},
+	"honeydew4":            {0x83, 0x8B, 0x83},
+	"hotpink":              {0xFF, 0x69, 0xB4},
+	"hotpink1":             {0xFF, 0x6E, 0xB4},
+	"hotpink2":             {0xEE, 0x6A, 0xA7},
+	"hotpink3":             {0xCD, 0x60, 0x90},
+	"hotpink4":             {0x8B, 0x3A, 0x62},
+	"indianred":            {0xCD, 0x5C, 0x5C},
+	"indianred1":           {0xFF, 0x6A, 0x6A},
+	"indianred2":           {0xEE, 0x63, 0x63},
+	"indianred3":           {0xCD, 0x55, 0x55},
+	"indianred4":           {0x8B, 0x3A, 0x3A},
+	"ivory":                {0xFF, 0xFF, 0xF0},
+	"ivory1":               {0xFF, 0xFF, 0xF0},
+	"ivory2":               {0xEE, 0xEE, 0xE0},
+	"ivory3":               {0xCD, 0xCD, 0xC1},
+	"ivory4":               {0x8B, 0x8B, 0x83},
+	"khaki":                {0xF0, 0xE6, 0x8C},
+	"khaki1":               {0xFF, 0xF6, 0x8F},
+	"khaki2":               {0xEE, 0xE6, 0x85},
+	"khaki3":               {0xCD, 0xC6, 0x73},
+	"khaki4":               {0x8B, 0x86, 0x4E},
+	"lavender":             {0xE6, 0xE6, 0xFA},
+	"lavenderblush":        {0xFF, 0xF0, 0xF5},
+	"lavenderblush1":       {0xFF, 0xF0, 0xF5},
+	"lavenderblush2":       {0xEE, 0xE0, 0xE5},
+	"lavenderblush3":       {0xCD, 0xC1, 0xC5},
+	"lavenderblush4":       {0x8B, 0x83, 0x86},
+	"lawngreen":            {0x7C, 0xFC, 0x00},
+	"lemonchiffon":         {0xFF, 0xFA, 0xCD},
+	"lemonchiffon1":        {0xFF, 0xFA, 0xCD},
+	"lemonchiffon2":        {0xEE, 0xE9, 0xBF},
+	"lemonchiffon3":        {0xCD, 0xC9, 0xA5},
+	"lemonchiffon4":        {0x8B, 0x89, 0x70},
+	"lightblue":            {0xAD, 0xD8, 0xE6},
+	"lightblue1":           {0xBF, 0xEF, 0xFF},
+	"lightblue2":           {0xB2, 0xDF, 0xEE},
+	"lightblue3":           {0x9A, 0xC0, 0xCD},
+	"lightblue4":           {0x68, 0x83, 0x8B},
+	"lightcoral":           {0xF0, 0x80, 0x80},
+	"lightcyan":            {0xE0, 0xFF, 0xFF},
+	"lightcyan1":           {0xE0, 0xFF, 0xFF},
+	"lightcyan2":           {0xD1, 0xEE, 0xEE},
+	"lightcyan3":           {0xB4, 0xCD, 0xCD},
+	"lightcyan4":           {0x7A, 0x8B, 0x8B},
+	"lightgoldenrod":       {0xEE, 0xDD, 0x82},
+	"lightgoldenrod1":      {0xFF, 0xEC, 0x8B},
+	"lightgoldenrod2":      {0xEE, 0xDC, 0x82},
+	"lightgoldenrod3":      {0xCD, 0xBE, 0x70},
+	"lightgoldenrod4":      {0x8B, 0x81, 0x4C},
+	"lightgoldenrodyellow": {0xFA, 0xFA, 0xD2},
+	"lightgray":            {0xD3, 0xD3, 0xD3},
+	"lightgreen":           {0x90, 0xEE, 0x90},
+	"lightgrey":            {0xD3, 0xD3, 0xD3},
+	"lightpink":            {0xFF, 0xB6, 0xC1},
+	"lightpink1":           {0xFF, 0xAE, 0xB9},
+	"lightpink2":           {0xEE, 0xA2, 0xAD},
+	"lightpink3":           {0xCD, 0x8C, 0x95},
+	"lightpink4":           {0x8B, 0x5F, 0x65},
+	"lightsalmon":          {0xFF, 0xA0, 0x7A},
+	"lightsalmon1":         {0xFF, 0xA0, 0x7A},
+	"lightsalmon2":         {0xEE, 0x95, 0x72},
+	"lightsalmon3":         {0xCD, 0x81, 0x62},
+	"lightsalmon4":         {0x8B, 0x57, 0x42},
+	"lightseagreen":        {0x20, 0xB2, 0xAA},
+	"lightskyblue":         {0x87, 0xCE, 0xFA},
+	"lightskyblue1":        {0xB0, 0xE2, 0xFF},
+	"lightskyblue2":        {0xA4, 0xD3, 0xEE},
+	"lightskyblue3":        {0x8D, 0xB6, 0xCD},
+	"lightskyblue4":        {0x60, 0x7B, 0x8B},
+	"lightslateblue":       {0x84, 0x70, 0xFF},
+	"lightslategray":       {0x77, 0x88, 0x99},
+	"lightslategrey":       {0x77, 0x88, 0x99},
+	"lightsteelblue":       {0xB0, 0xC4, 0xDE},
+	"lightsteelblue1":      {0xCA, 0xE1, 0xFF},
+	"lightsteelblue2":      {0xBC, 0xD2, 0xEE},
+	"lightsteelblue3":      {0xA2, 0xB5, 0xCD},
+	"lightsteelblue4":      {0x6E, 0x7B, 0x8B},
+	"lightyellow":          {0xFF, 0xFF, 0xE0},
+	"lightyellow1":         {0xFF, 0xFF, 0xE0},
+	"lightyellow2":         {0xEE, 0xEE, 0xD1},
+	"lightyellow3":         {0xCD, 0xCD, 0xB4},
+	"lightyellow4":         {0x8B, 0x8B, 0x7A},
+	"limegreen":            {0x32, 0xCD, 0x32},
+	"linen":                {0xFA, 0xF0, 0xE6},
+	"magenta":              {0xFF, 0x00, 0xFF},
+	"magenta1":             {0xFF, 0x00, 0xFF},
+	"magenta2":             {0xEE, 0x00, 0xEE},
+	"magenta3":             {0xCD, 0x00, 0xCD},
+	"magenta4":             {0x8B, 0x00, 0x8B},
+	"maroon":               {0xB0, 0x30, 0x60},
+	"maroon1":              {0xFF, 0x34, 0xB3},
+	"maroon2":              {0xEE, 0x30, 0xA7},
+	"maroon3":              {0xCD, 0x29, 0x90},
+	"maroon4":              {0x8B, 0x1C, 0x62},
+	"mediumaquamarine":     {0x66, 0xCD, 0xAA},
+	"mediumblue":           {0x00, 0x00, 0xCD},
+	"mediumorchid":         {0xBA, 0x55, 0xD3},
+	"mediumorchid1":        {0xE0, 0x66, 0xFF},
+	"mediumorchid2":        {0xD1, 0x5F, 0xEE},
+	"mediumorchid3":        {0xB4, 0x52, 0xCD},
+	"mediumorchid4":        {0x7A, 0x37, 0x8B},
+	"mediumpurple":         {0x93, 0x70, 0xDB},
+	"mediumpurple1":        {0xAB, 0x82, 0xFF},
+	"mediumpurple2":        {0x9F, 0x79, 0xEE},
+	"mediumpurple3":        {0x89, 0x68, 0xCD},
+	"mediumpurple4":        {0x5D, 0x47, 0x8B},
+	"mediumseagreen":       {0x3C, 0xB3, 0x71},
+	"mediumslateblue":      {0x7B, 0x68, 0xEE},
+	"mediumspringgreen":    {0x00, 0xFA, 0x9A},
+	"mediumturquoise":      {0x48, 0xD1, 0xCC},
+	"mediumvioletred":      {0xC7, 0x15, 0x85},
+	"midnightblue":         {0x19, 0x19, 0x70},
+	"mintcream":            {0xF5, 0xFF, 0xFA},
+	"mistyrose":            {0xFF, 0xE4, 0xE1},
+	"mistyrose1":           {0xFF, 0xE4, 0xE1},
+	"mistyrose2":           {0xEE, 0xD5, 0xD2},
+	"mistyrose3":           {0xCD, 0xB7, 0xB5},
+	"mistyrose4":           {0x8B, 0x7D, 0x7B},
+	"moccasin":             {0xFF, 0xE4, 0xB5},
+	"navajowhite":          {0xFF, 0xDE, 0xAD},
+	"navajowhite1":         {0xFF, 0xDE, 0xAD},
+	"navajowhite2":         {0xEE, 0xCF, 0xA1},
+	"navajowhite3":         {0xCD, 0xB3, 0x8B},
+	"navajowhite4":         {0x8B, 0x79, 0x5E},
+	"navy":                 {0x00, 0x00, 0x80},
+	"navyblue":             {0x00, 0x00, 0x80},
+	"oldlace":              {0xFD, 0xF5, 0xE6},
+	"olivedrab":            {0x6B, 0x8E, 0x23},
+	"olivedrab1":           {0xC0, 0xFF, 0x3E},
+	"olivedrab2":           {0xB3, 0xEE, 0x3A},
+	"olivedrab3":           {0x9A, 0xCD, 0x32},
+	"olivedrab4":           {0x69, 0x8B, 0x22},
+	"orange":               {0xFF, 0xA5, 0x00},
+	"orange1":              {0xFF, 0xA5, 0x00},
+	"orange2":              {0xEE, 0x9A, 0x00},
+	"orange3":              {0xCD, 0x85, 0x00},
+	"orange4":              {0x8B, 0x5A, 0x00},
+	"orangered":            {0xFF, 0x45, 0x00},
+	"orangered1":           {0xFF, 0x45, 0x00},
+	"orangered2":           {0xEE, 0x40, 0x00},
+	"orangered3":           {0xCD, 0x37, 0x00},
+	"orangered4":           {0x8B, 0x25, 0x00},
+	"orchid":               {0xDA, 0x70, 0xD6},
+	"orchid1":              {0xFF, 0x83, 0xFA},
+	"orchid2":              {0xEE, 0x7A, 0xE9},
+	"orchid3":              {0xCD, 0x69, 0xC9},
+	"orchid4":              {0x8B, 0x47, 0x89},
+	"palegoldenrod":        {0xEE, 0xE8, 0xAA},
+	"palegreen":            {0x98, 0xFB, 0x98},
+	"palegreen1":           {0x9A, 0xFF, 0x9A},
+	"palegreen2":           {0x90, 0xEE, 0x90},
+	"palegreen3":           {0x7C, 0xCD, 0x7C},
+	"palegreen4":           {0x54, 0x8B, 0x54},
+	"paleturquoise":        {0xAF, 0xEE, 0xEE},
+	"paleturquoise1":       {0xBB, 0xFF, 0xFF},
+	"paleturquoise2":       {0xAE, 0xEE, 0xEE},
+	"paleturquoise3":       {0x96, 0xCD, 0xCD},
+	"paleturquoise4":       {0x66, 0x8B, 0x8B},
+	"palevioletred":        {0xDB, 0x70, 0x93},
+	"palevioletred1":       {0xFF, 0x82, 0xAB},
+	"palevioletred2":       {0xEE, 0x79, 0x9F},
+	"palevioletred3":       {0xCD, 0x68, 0x89},
+	"palevioletred4":       {0x8B, 0x47, 0x5D},
+	"papayawhip":           {0xFF, 0xEF, 0xD5},
+	"peachpuff":            {0xFF, 0xDA, 0xB9},
+	"peachpuff1":           {0xFF, 0xDA, 0xB9},
+	"peachpuff2":           {0xEE, 0xCB, 0xAD},
+	"peachpuff3":           {0xCD, 0xAF, 0x95},
+	"peachpuff4":           {0x8B, 0x77, 0x65},
+	"peru":                 {0xCD, 0x85, 0x3F},
+	"pink":                 {0xFF, 0xC0, 0xCB},
+	"pink1":                {0xFF, 0xB5, 0xC5},
+	"pink2":                {0xEE, 0xA9, 0xB8},
+	"pink3":                {0xCD, 0x91, 0x9E},
+	"pink4":                {0x8B, 0x63, 0x6C},
+	"plum":                 {0xDD, 0xA0, 0xDD},
+	"plum1":                {0xFF, 0xBB, 0xFF},
+	"plum2":                {0xEE, 0xAE, 0xEE},
+	"plum3":                {0xCD, 0x96, 0xCD},
+	"plum4":                {0x8B, 0x66, 0x8B},
+	"powderblue":           {0xB0, 0xE0, 0xE6},
+	"purple":               {0xA0, 0x20, 0xF0},
+	"purple1":              {0x9B, 0x30, 0xFF},
+	"purple2":              {0x91, 0x2C, 0xEE},
+	"purple3":              {0x7D, 0x26, 0xCD},
+	"purple4":              {0x55, 0x1A, 0x8B},
+	"red":                  {0xFF, 0x00, 0x00},
+	"red1":                 {0xFF, 0x00, 0x00},
+	"red2":                 {0xEE, 0x00, 0x00},
+	"red3":                 {0xCD, 0x00, 0x00},
+	"red4":                 {0x8B, 0x00, 0x00},
+	"rosybrown":            {0xBC, 0x8F, 0x8F},
+	"rosybrown1":           {0xFF, 0xC1, 0xC1},
+	"rosybrown2":           {0xEE, 0xB4, 0xB4},
+	"rosybrown3":           {0xCD, 0x9B, 0x9B},
+	"rosybrown4":           {0x8B, 0x69, 0x69},
+	"royalblue":            {0x41, 0x69, 0xE1},
+	"royalblue1":           {0x48, 0x76, 0xFF},
+	"royalblue2":           {0x43, 0x6E, 0xEE},
+	"royalblue3":           {0x3A, 0x5F, 0xCD},
+	"royalblue4":           {0x27, 0x40, 0x8B},
+	"saddlebrown":          {0x8B, 0x45, 0x13},
+	"salmon":               {0xFA, 0x80, 0x72},
+	"salmon1":              {0xFF, 0x8C, 0x69},
+	"salmon2":              {0xEE, 0x82, 0x62},
+	"salmon3":              {0xCD, 0x70, 0x54},
+	"salmon4":              {0x8B, 0x4C, 0x39},
+	"sandybrown":           {0xF4, 0xA4, 0x60},
+	"seagreen":             {0x2E, 0x8B, 0x57},
+	"seagreen1":            {0x54, 0xFF, 0x9F},
+	"seagreen2":            {0x4E, 0xEE, 0x94},
+	"seagreen3":            {0x43, 0xCD, 0x80},
+	"seagreen4":            {0x2E, 0x8B, 0x57},
+	"seashell":             {0xFF, 0xF5, 0xEE},
+	"seashell1":            {0xFF, 0xF5, 0xEE},
+	"seashell2":            {0xEE, 0xE5, 0xDE},
+	"seashell3":            {0xCD, 0xC5, 0xBF},
+	"seashell4":            {0x8B, 0x86, 0x82},
+	"sienna":               {0xA0, 0x52, 0x2D},
+	"sienna1":              {0xFF, 0x82, 0x47},
+	"sienna2":              {0xEE, 0x79, 0x42},
+	"sienna3":              {0xCD, 0x68, 0x39},
+	"sienna4":              {0x8B, 0x47, 0x26},
+	"skyblue":              {0x87, 0xCE, 0xEB},
+	"skyblue1":             {0x87, 0xCE, 0xFF},
+	"skyblue2":             {0x7E, 0xC0, 0xEE},
+	"skyblue3":             {0x6C, 0xA6, 0xCD},
+	"skyblue4":             {0x4A, 0x70, 0x8B},
+	"slateblue":            {0x6A, 0x5A, 0xCD},
+	"slateblue1":           {0x83, 0x6F, 0xFF},
+	"slateblue2":           {0x7A, 0x67, 0xEE},
+	"slateblue3":           {0x69, 0x59, 0xCD},
+	"slateblue4":           {0x47, 0x3C, 0x8B},
+	"slategray":            {0x70, 0x80, 0x90},
+	"slategray1":           {0xC6, 0xE2, 0xFF},
+	"slategray2":           {0xB9, 0xD3, 0xEE},
+	"slategray3":           {0x9F, 0xB6, 0xCD},
+	"slategray4":           {0x6C, 0x7B, 0x8B},
+	"slategrey":            {0x70, 0x80, 0x90},
+	"snow":                 {0xFF, 0xFA, 0xFA},
+	"snow1":                {0xFF, 0xFA, 0xFA},
+	"snow2":                {0xEE, 0xE9, 0xE9},
+	"snow3":                {0xCD, 0xC9, 0xC9},
+	"snow4":                {0x8B, 0x89, 0x89},
+	"springgreen":          {0x00, 0xFF, 0x7F},
+	"springgreen1":         {0x00, 0xFF, 0x7F},
+	"springgreen2":         {0x00, 0xEE, 0x76},
+	"springgreen3":         {0x00, 0xCD, 0x66},
+	"springgreen4":         {0x00, 0x8B, 0x45},
+	"steelblue":            {0x46, 0x82, 0xB4},
+	"steelblue1":           {0x63, 0xB8, 0xFF},
+	"steelblue2":           {0x5C, 0xAC, 0xEE},
+	"steelblue3":           {0x4F, 0x94, 0xCD},
+	"steelblue4":           {0x36, 0x64, 0x8B},
+	"tan":                  {0xD2, 0xB4, 0x8C},
+	"tan1":                 {0xFF, 0xA5, 0x4F},
+	"tan2":                 {0xEE, 0x9A, 0x49},
+	"tan3":                 {0xCD, 0x85, 0x3F},
+	"tan4":                 {0x8B, 0x5A, 0x2B},
+	"thistle":              {0xD8, 0xBF, 0xD8},
+	"thistle1":             {0xFF, 0xE1, 0xFF},
+	"thistle2":             {0xEE, 0xD2, 0xEE},
+	"thistle3":             {0xCD, 0xB5, 0xCD},
+	"thistle4":             {0x8B, 0x7B, 0x8B},
+	"tomato":               {0xFF, 0x63, 0x47},
+	"tomato1":              {0xFF, 0x63, 0x47},
+	"tomato2":              {0xEE, 0x5C, 0x42},
+	"tomato3":              {0xCD, 0x4F, 0x39},
+	"tomato4":              {0x8B, 0x36, 0x26},
+	"turquoise":            {0x40, 0xE0, 0xD0},
+	"turquoise1":           {0x00, 0xF5, 0xFF},
+	"turquoise2":           {0x00, 0xE5, 0xEE},
+	"turquoise3":           {0x00, 0xC5, 0xCD},
+	"turquoise4":           {0x00, 0x86, 0x8B},
+	"violet":               {0xEE, 0x82, 0xEE},
+	"violetred":            {0xD0, 0x20, 0x90},
+	"violetred1":           {0xFF, 0x3E, 0x96},
+	"violetred2":           {0xEE, 0x3A, 0x8C},
+	"violetred3":           {0xCD, 0x32, 0x78},
+	"violetred4":           {0x8B, 0x22, 0x52},
+	"wheat":                {0xF5, 0xDE, 0xB3},
+	"wheat1":               {0xFF, 0xE7, 0xBA},
+	"wheat2":               {0xEE, 0xD8, 0xAE},
+	"wheat3":               {0xCD, 0xBA, 0x96},
+	"wheat4":               {0x8B, 0x7E, 0x66},
+	"white":                {0xFF, 0xFF, 0xFF},
+	"whitesmoke":           {0xF5, 0xF5, 0xF5},
+	"yellow":               {0xFF, 0xFF, 0x00},
+	"yellow1":              {0xFF, 0xFF, 0x00},
+	"yellow2":              {0xEE, 0xEE, 0x00},
+	"yellow3":              {0xCD, 0xCD, 0x00},
+	"yellow4":              {0x8B, 0x8B, 0x00},
+	"yellowgreen":          {0x9A, 0xCD, 0x32},
+}
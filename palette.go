@@ -0,0 +1,166 @@
+package ansi
+
+import (
+	"sync"
+)
+
+// _Palette holds the default colors of the xterm 256 color
+// palette. The first 16 colors are usually redefined by the
+// terminal's theme, the next 216 form a 6x6x6 color cube and the
+// last 24 form a grayscale ramp.
+var _Palette = func() [256]RGB {
+	var p [256]RGB
+
+	copy(p[:16], []RGB{
+		{0x00, 0x00, 0x00}, {0xCD, 0x00, 0x00}, {0x00, 0xCD, 0x00}, {0xCD, 0xCD, 0x00},
+		{0x00, 0x00, 0xEE}, {0xCD, 0x00, 0xCD}, {0x00, 0xCD, 0xCD}, {0xE5, 0xE5, 0xE5},
+		{0x7F, 0x7F, 0x7F}, {0xFF, 0x00, 0x00}, {0x00, 0xFF, 0x00}, {0xFF, 0xFF, 0x00},
+		{0x5C, 0x5C, 0xFF}, {0xFF, 0x00, 0xFF}, {0x00, 0xFF, 0xFF}, {0xFF, 0xFF, 0xFF},
+	})
+
+	for i := range 216 {
+		p[16+i] = RGB{_CubeLevels[i/36], _CubeLevels[i/6%6], _CubeLevels[i%6]}
+	}
+
+	for i := range 24 {
+		v := uint8(8 + 10*i)
+		p[232+i] = RGB{v, v, v}
+	}
+
+	return p
+}()
+
+var _CubeLevels = [6]uint8{0x00, 0x5F, 0x87, 0xAF, 0xD7, 0xFF}
+
+// NearestInPalette returns the index of the color of palette
+// that looks the closest to c, as measured by the euclidean
+// distance in the [OKLab] color space, or -1 if palette is empty.
+// The conversions to OKLab are kept in a small cache, so looking
+// colors up in the same palette over and over is cheap.
+func NearestInPalette(c Color, palette []Color) int {
+	rgbs := make([]RGB, len(palette))
+	for i, p := range palette {
+		rgbs[i] = RGBFromColor(p)
+	}
+
+	target := RGBFromColor(c)
+	labs := make([]OKLab, len(palette))
+
+	_OKLabCache.Lock()
+	for i, rgb := range rgbs {
+		labs[i] = _OKLabCached(rgb)
+	}
+	lab := _OKLabCached(target)
+	_OKLabCache.Unlock()
+
+	return _Nearest(lab, labs)
+}
+
+// _OKLabCache is a small direct-mapped cache of conversions to the
+// [OKLab] color space, used by [NearestInPalette]. Each entry holds
+// the color converted, with its highest bit set if valid.
+var _OKLabCache struct {
+	sync.Mutex
+	keys [256]uint32
+	labs [256]OKLab
+}
+
+// _OKLabCached returns c in the [OKLab] color space, caching the
+// result. The caller must hold the lock of [_OKLabCache].
+func _OKLabCached(c RGB) OKLab {
+	key := 1<<31 | uint32(c.R)<<16 | uint32(c.G)<<8 | uint32(c.B)
+	slot := key * 0x9E3779B1 >> 24
+
+	if _OKLabCache.keys[slot] != key {
+		_OKLabCache.keys[slot] = key
+		_OKLabCache.labs[slot] = OKLabFromColor(c)
+	}
+
+	return _OKLabCache.labs[slot]
+}
+
+// _NearestANSI16 returns the index of the color of the basic 16
+// color palette closest to c.
+func _NearestANSI16(c RGB) uint8 {
+	return _NearestCached(c, _ANSI16Cache)
+}
+
+// _NearestANSI256 returns the index of the color of the xterm 256
+// color palette closest to c. The first 16 colors are not taken
+// into account, since they depend on the terminal's theme.
+func _NearestANSI256(c RGB) uint8 {
+	return _NearestCached(c, _ANSI256Cache)
+}
+
+// _PaletteOKLab holds the colors of [_Palette] in the [OKLab]
+// color space, so they are not converted on every lookup.
+var _PaletteOKLab = func() [256]OKLab {
+	var p [256]OKLab
+	for i, c := range _Palette {
+		p[i] = OKLabFromColor(c)
+	}
+
+	return p
+}()
+
+// Palettes looked up through [_NearestCache].
+const (
+	_ANSI16Cache = iota
+	_ANSI256Cache
+)
+
+// _NearestCache is a small direct-mapped cache of the lookups
+// done by [_NearestCached]. Each entry holds, in its lower 24 bits,
+// the color looked up, then the palette it was looked up in, then
+// the index found, and is valid if its highest bit is set.
+var _NearestCache struct {
+	sync.Mutex
+	entries [256]uint64
+}
+
+// _NearestCached returns the index of the color of the given
+// palette closest to c, caching the result.
+func _NearestCached(c RGB, palette int) uint8 {
+	key := uint64(palette)<<24 | uint64(c.R)<<16 | uint64(c.G)<<8 | uint64(c.B)
+	slot := uint32(key) * 0x9E3779B1 >> 24
+
+	_NearestCache.Lock()
+	entry := _NearestCache.entries[slot]
+	_NearestCache.Unlock()
+
+	if entry>>63 == 1 && entry&0x1FFFFFF == key {
+		return uint8(entry >> 32)
+	}
+
+	var index uint8
+	switch palette {
+	case _ANSI16Cache:
+		index = uint8(_Nearest(OKLabFromColor(c), _PaletteOKLab[:16]))
+	case _ANSI256Cache:
+		index = 16 + uint8(_Nearest(OKLabFromColor(c), _PaletteOKLab[16:]))
+	}
+
+	_NearestCache.Lock()
+	_NearestCache.entries[slot] = 1<<63 | uint64(index)<<32 | key
+	_NearestCache.Unlock()
+
+	return index
+}
+
+// _Nearest returns the index of the color of palette closest to
+// c, or -1 if palette is empty.
+func _Nearest(c OKLab, palette []OKLab) int {
+	best, best_dist := -1, float32(0)
+	for i, p := range palette {
+		dl := c.L - p.L
+		da := c.A - p.A
+		db := c.B - p.B
+
+		dist := dl*dl + da*da + db*db
+		if best < 0 || dist < best_dist {
+			best, best_dist = i, dist
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,499 @@
+package ansi
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// TokenKind identifies the kind of a [Token].
+type TokenKind int
+
+const (
+	TokenText    TokenKind = iota // printable text
+	TokenControl                  // C0 control character, or DEL
+	TokenEsc                      // ESC sequence, e.g. ESC c
+	TokenCSI                      // Control Sequence Introducer, ESC [
+	TokenOSC                      // Operating System Command, ESC ]
+	TokenDCS                      // Device Control String, ESC P
+	TokenSOS                      // Start Of String, ESC X
+	TokenPM                       // Privacy Message, ESC ^
+	TokenAPC                      // Application Program Command, ESC _
+	TokenSS3                      // Single Shift Three, ESC O
+)
+
+// Token is a single unit of a stream of text interleaved with
+// escape sequences, as yielded by a [Parser].
+type Token struct {
+	Kind TokenKind
+
+	// Raw holds the bytes that made up the token, verbatim.
+	Raw string
+
+	// Private holds the private marker ('<', '=', '>' or '?')
+	// of CSI and DCS sequences, or 0 if there is none.
+	Private byte
+
+	// Intermediates holds the intermediate bytes (0x20-0x2F)
+	// of ESC, CSI and DCS sequences.
+	Intermediates string
+
+	// Final holds the final byte of ESC, CSI, DCS and SS3
+	// sequences.
+	Final byte
+
+	// Params holds the parameters of CSI and DCS sequences,
+	// each one with its colon separated subparameters. Omitted
+	// values are represented by -1.
+	Params [][]int
+
+	// Data holds the payload of OSC, DCS, SOS, PM and APC
+	// strings, without the introducer and the terminator.
+	Data string
+}
+
+// Param returns the first subparameter of the i-th parameter
+// of the token, or def if such parameter is absent or omitted.
+func (t *Token) Param(i, def int) int {
+	if i >= len(t.Params) || len(t.Params[i]) == 0 || t.Params[i][0] < 0 {
+		return def
+	}
+
+	return t.Params[i][0]
+}
+
+// Parser is a streaming tokenizer of ANSI escape sequences. It
+// reads from an underlying reader and splits its contents in
+// [Token]s, following the state machine of the DEC VT500 series,
+// as described by Paul Flo Williams.
+//
+// Since the input is assumed to be UTF-8 encoded, C1 controls are
+// only recognized by their 7-bit forms (ESC followed by a byte in
+// the range 0x40-0x5F). Runs of text may be split across multiple
+// consecutive [TokenText] tokens, but never in the middle of a
+// UTF-8 encoded rune.
+type Parser struct {
+	r     io.Reader
+	buf   []byte
+	queue []Token
+	m     _Machine
+	err   error
+}
+
+// NewParser returns a new [Parser] reading from r.
+func NewParser(r io.Reader) *Parser {
+	return &Parser{r: r, buf: make([]byte, 4096)}
+}
+
+// Next returns the next token read from the underlying reader. At
+// the end of the input, Next returns [io.EOF]. Incomplete escape
+// sequences at the end of the input are discarded.
+func (p *Parser) Next() (Token, error) {
+	for len(p.queue) == 0 {
+		if p.err != nil {
+			return Token{}, p.err
+		}
+
+		n, err := p.r.Read(p.buf)
+		p.m.Feed(p.buf[:n], p._Push)
+
+		if err != nil {
+			p.m.Flush(p._Push)
+			p.err = err
+		}
+	}
+
+	t := p.queue[0]
+	p.queue = p.queue[1:]
+	return t, nil
+}
+
+func (p *Parser) _Push(t Token) { p.queue = append(p.queue, t) }
+
+// Parse splits s into its [Token]s. See [Parser] for details.
+func Parse(s string) []Token {
+	var tokens []Token
+	push := func(t Token) { tokens = append(tokens, t) }
+
+	var m _Machine
+	m.Feed([]byte(s), push)
+	m.Flush(push)
+	return tokens
+}
+
+type _State uint8
+
+const (
+	_Ground _State = iota
+	_Escape
+	_EscapeIntermediate
+	_SS3Entry
+	_SeqEntry
+	_SeqParam
+	_SeqIntermediate
+	_SeqIgnore
+	_DcsPassthrough
+	_DcsIgnore
+	_OscString
+	_StringPassthrough
+	_StringEsc
+)
+
+const (
+	_MaxParams = 32
+	_MaxParam  = 0xFFFF
+)
+
+// _Machine is the push based state machine behind [Parser]. Its
+// zero-value is ready to use.
+type _Machine struct {
+	state  _State
+	raw    []byte
+	text   []byte
+	tok    Token
+	inter  []byte
+	data   []byte
+	params [][]int
+}
+
+// Feed advances the machine through every byte of p, calling emit
+// for every completed token.
+func (m *_Machine) Feed(p []byte, emit func(Token)) {
+	for _, c := range p {
+		m._Step(c, emit)
+	}
+
+	// Flush text eagerly, so streams do not stall, but hold back
+	// a trailing incomplete rune.
+	if m.state == _Ground && len(m.text) > 0 {
+		keep := _IncompleteRuneLen(m.text)
+		if len(m.text) > keep {
+			rest := m.text[len(m.text)-keep:]
+			m.text = m.text[:len(m.text)-keep]
+			m._EmitText(emit)
+			m.text = append(m.text, rest...)
+		}
+	}
+}
+
+// Flush emits any pending text and discards any incomplete escape
+// sequence, returning the machine to its initial state.
+func (m *_Machine) Flush(emit func(Token)) {
+	m._EmitText(emit)
+	m._Clear()
+	m.state = _Ground
+}
+
+func (m *_Machine) _Step(c byte, emit func(Token)) {
+	// Transitions from anywhere.
+	switch {
+	case c == 0x18 || c == 0x1A:
+		m._EmitText(emit)
+		m._Clear()
+		m.state = _Ground
+		emit(Token{Kind: TokenControl, Raw: string(c)})
+		return
+
+	case c == 0x1B:
+		switch m.state {
+		case _OscString, _DcsPassthrough, _DcsIgnore, _StringPassthrough:
+			m.raw = append(m.raw, c)
+			m.state = _StringEsc
+			return
+		}
+
+		m._EmitText(emit)
+		m._Clear()
+		m.raw = append(m.raw, c)
+		m.state = _Escape
+		return
+	}
+
+	switch m.state {
+	case _Ground:
+		if c < 0x20 || c == 0x7F {
+			m._EmitText(emit)
+			emit(Token{Kind: TokenControl, Raw: string(c)})
+			return
+		}
+
+		m.text = append(m.text, c)
+
+	case _Escape:
+		if m._Execute(c, emit) {
+			return
+		}
+
+		if c >= 0x80 {
+			m._Abort(c, emit)
+			return
+		}
+
+		m.raw = append(m.raw, c)
+		switch {
+		case c <= 0x2F:
+			m.inter = append(m.inter, c)
+			m.state = _EscapeIntermediate
+		case c == '[':
+			m.tok.Kind = TokenCSI
+			m.state = _SeqEntry
+		case c == ']':
+			m.tok.Kind = TokenOSC
+			m.state = _OscString
+		case c == 'P':
+			m.tok.Kind = TokenDCS
+			m.state = _SeqEntry
+		case c == 'X':
+			m.tok.Kind = TokenSOS
+			m.state = _StringPassthrough
+		case c == '^':
+			m.tok.Kind = TokenPM
+			m.state = _StringPassthrough
+		case c == '_':
+			m.tok.Kind = TokenAPC
+			m.state = _StringPassthrough
+		case c == 'O':
+			m.state = _SS3Entry
+		case c == 0x7F:
+			m.raw = m.raw[:len(m.raw)-1]
+		default:
+			m._Dispatch(TokenEsc, c, emit)
+		}
+
+	case _EscapeIntermediate:
+		if m._Execute(c, emit) {
+			return
+		}
+
+		if c >= 0x80 {
+			m._Abort(c, emit)
+			return
+		}
+
+		m.raw = append(m.raw, c)
+		switch {
+		case c <= 0x2F:
+			m.inter = append(m.inter, c)
+		case c == 0x7F:
+			m.raw = m.raw[:len(m.raw)-1]
+		default:
+			m._Dispatch(TokenEsc, c, emit)
+		}
+
+	case _SS3Entry:
+		if m._Execute(c, emit) {
+			return
+		}
+
+		if c == 0x7F {
+			return
+		}
+
+		if c < 0x40 || c >= 0x80 {
+			m._Abort(c, emit)
+			return
+		}
+
+		m.raw = append(m.raw, c)
+		m._Dispatch(TokenSS3, c, emit)
+
+	case _SeqEntry, _SeqParam, _SeqIntermediate, _SeqIgnore:
+		if m.tok.Kind == TokenCSI && m._Execute(c, emit) {
+			return
+		}
+
+		if c < 0x20 || c == 0x7F {
+			return
+		}
+
+		if c >= 0x80 {
+			m._Abort(c, emit)
+			return
+		}
+
+		m.raw = append(m.raw, c)
+		m._Sequence(c)
+		if c < 0x40 {
+			return
+		}
+
+		switch {
+		case m.tok.Kind == TokenDCS && m.state == _SeqIgnore:
+			m.state = _DcsIgnore
+		case m.tok.Kind == TokenDCS:
+			m.tok.Final = c
+			m.state = _DcsPassthrough
+		case m.state == _SeqIgnore:
+			m._Clear()
+			m.state = _Ground
+		default:
+			m._Dispatch(TokenCSI, c, emit)
+		}
+
+	case _DcsIgnore:
+		m.raw = append(m.raw, c)
+
+	case _DcsPassthrough, _StringPassthrough:
+		m.raw = append(m.raw, c)
+		m.data = append(m.data, c)
+
+	case _OscString:
+		m.raw = append(m.raw, c)
+		if c == 0x07 {
+			m._Dispatch(TokenOSC, 0, emit)
+			return
+		}
+
+		if c >= 0x20 {
+			m.data = append(m.data, c)
+		}
+
+	case _StringEsc:
+		if c == '\\' {
+			m.raw = append(m.raw, c)
+			if m.tok.Kind != TokenDCS || m.tok.Final != 0 {
+				m._Dispatch(m.tok.Kind, m.tok.Final, emit)
+			} else {
+				m._Clear()
+				m.state = _Ground
+			}
+			return
+		}
+
+		// The string was interrupted by another escape sequence.
+		raw := m.raw[:len(m.raw)-1]
+		m.raw = raw
+		if m.tok.Kind != TokenDCS || m.tok.Final != 0 {
+			m._Dispatch(m.tok.Kind, m.tok.Final, emit)
+		}
+
+		m._Clear()
+		m.raw = append(m.raw, 0x1B)
+		m.state = _Escape
+		m._Step(c, emit)
+	}
+}
+
+// _Execute handles C0 controls found in the middle of a sequence,
+// which are executed without interrupting it. It reports whether
+// c was consumed.
+func (m *_Machine) _Execute(c byte, emit func(Token)) bool {
+	if c >= 0x20 {
+		return false
+	}
+
+	emit(Token{Kind: TokenControl, Raw: string(c)})
+	return true
+}
+
+// _Sequence advances the parameter, intermediate and final byte
+// parsing shared by CSI and DCS sequences.
+func (m *_Machine) _Sequence(c byte) {
+	switch {
+	case m.state == _SeqIgnore:
+
+	case c <= 0x2F:
+		m.inter = append(m.inter, c)
+		m.state = _SeqIntermediate
+
+	case c <= 0x3B:
+		if m.state == _SeqIntermediate {
+			m.state = _SeqIgnore
+			return
+		}
+		m._Param(c)
+		m.state = _SeqParam
+
+	case c <= 0x3F:
+		if m.state != _SeqEntry {
+			m.state = _SeqIgnore
+			return
+		}
+		m.tok.Private = c
+		m.state = _SeqParam
+	}
+}
+
+func (m *_Machine) _Param(c byte) {
+	if len(m.params) == 0 {
+		m.params = append(m.params, []int{-1})
+	}
+
+	last := len(m.params) - 1
+	sub := m.params[last]
+
+	switch c {
+	case ';':
+		if len(m.params) < _MaxParams {
+			m.params = append(m.params, []int{-1})
+		}
+
+	case ':':
+		if len(sub) < _MaxParams {
+			m.params[last] = append(sub, -1)
+		}
+
+	default:
+		v := &sub[len(sub)-1]
+		if *v < 0 {
+			*v = 0
+		}
+		*v = min(*v*10+int(c-'0'), _MaxParam)
+	}
+}
+
+func (m *_Machine) _Dispatch(kind TokenKind, final byte, emit func(Token)) {
+	t := m.tok
+	t.Kind = kind
+	t.Raw = string(m.raw)
+	t.Final = final
+	t.Intermediates = string(m.inter)
+	t.Params = m.params
+	t.Data = string(m.data)
+
+	m.params = nil
+	m._Clear()
+	m.state = _Ground
+	emit(t)
+}
+
+// _Abort drops the sequence being parsed and processes c again
+// from the ground state, so bytes that cannot end a sequence, such
+// as those of a UTF-8 encoded rune, are kept as text.
+func (m *_Machine) _Abort(c byte, emit func(Token)) {
+	m._Clear()
+	m.state = _Ground
+	m._Step(c, emit)
+}
+
+func (m *_Machine) _EmitText(emit func(Token)) {
+	if len(m.text) == 0 {
+		return
+	}
+
+	emit(Token{Kind: TokenText, Raw: string(m.text)})
+	m.text = m.text[:0]
+}
+
+func (m *_Machine) _Clear() {
+	m.raw = m.raw[:0]
+	m.inter = m.inter[:0]
+	m.data = m.data[:0]
+	m.params = nil
+	m.tok = Token{}
+}
+
+// _IncompleteRuneLen returns the length of the incomplete UTF-8
+// encoded rune at the end of p, if any.
+func _IncompleteRuneLen(p []byte) int {
+	for i := 1; i <= utf8.UTFMax && i <= len(p); i++ {
+		c := p[len(p)-i]
+		if utf8.RuneStart(c) {
+			if utf8.FullRune(p[len(p)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+
+	return 0
+}
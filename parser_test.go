@@ -0,0 +1,292 @@
+package ansi
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+)
+
+var _ParseTests = []struct {
+	name string
+	in   string
+	want []Token
+}{
+	{
+		name: "text",
+		in:   "héllo, 世界",
+		want: []Token{{Kind: TokenText, Raw: "héllo, 世界"}},
+	},
+	{
+		name: "controls",
+		in:   "a\tb\r\n\x7f",
+		want: []Token{
+			{Kind: TokenText, Raw: "a"},
+			{Kind: TokenControl, Raw: "\t"},
+			{Kind: TokenText, Raw: "b"},
+			{Kind: TokenControl, Raw: "\r"},
+			{Kind: TokenControl, Raw: "\n"},
+			{Kind: TokenControl, Raw: "\x7f"},
+		},
+	},
+	{
+		name: "csi without params",
+		in:   "\x1b[m",
+		want: []Token{{Kind: TokenCSI, Raw: "\x1b[m", Final: 'm'}},
+	},
+	{
+		name: "csi params",
+		in:   "\x1b[1;31mx",
+		want: []Token{
+			{Kind: TokenCSI, Raw: "\x1b[1;31m", Final: 'm', Params: [][]int{{1}, {31}}},
+			{Kind: TokenText, Raw: "x"},
+		},
+	},
+	{
+		name: "csi omitted params",
+		in:   "\x1b[;5H",
+		want: []Token{{Kind: TokenCSI, Raw: "\x1b[;5H", Final: 'H', Params: [][]int{{-1}, {5}}}},
+	},
+	{
+		name: "csi colon subparams",
+		in:   "\x1b[4:3;58:2::255:0:0m",
+		want: []Token{{
+			Kind:   TokenCSI,
+			Raw:    "\x1b[4:3;58:2::255:0:0m",
+			Final:  'm',
+			Params: [][]int{{4, 3}, {58, 2, -1, 255, 0, 0}},
+		}},
+	},
+	{
+		name: "csi param overflow",
+		in:   "\x1b[99999999A",
+		want: []Token{{Kind: TokenCSI, Raw: "\x1b[99999999A", Final: 'A', Params: [][]int{{_MaxParam}}}},
+	},
+	{
+		name: "csi private and intermediate",
+		in:   "\x1b[?25h\x1b[2 q",
+		want: []Token{
+			{Kind: TokenCSI, Raw: "\x1b[?25h", Private: '?', Final: 'h', Params: [][]int{{25}}},
+			{Kind: TokenCSI, Raw: "\x1b[2 q", Intermediates: " ", Final: 'q', Params: [][]int{{2}}},
+		},
+	},
+	{
+		name: "csi with control inside",
+		in:   "\x1b[1\n2m",
+		want: []Token{
+			{Kind: TokenControl, Raw: "\n"},
+			{Kind: TokenCSI, Raw: "\x1b[12m", Final: 'm', Params: [][]int{{12}}},
+		},
+	},
+	{
+		name: "csi malformed",
+		in:   "\x1b[1$2mx",
+		want: []Token{{Kind: TokenText, Raw: "x"}},
+	},
+	{
+		name: "esc",
+		in:   "\x1bc\x1b(B",
+		want: []Token{
+			{Kind: TokenEsc, Raw: "\x1bc", Final: 'c'},
+			{Kind: TokenEsc, Raw: "\x1b(B", Intermediates: "(", Final: 'B'},
+		},
+	},
+	{
+		name: "ss3",
+		in:   "\x1bOA",
+		want: []Token{{Kind: TokenSS3, Raw: "\x1bOA", Final: 'A'}},
+	},
+	{
+		name: "esc aborted by rune",
+		in:   "a\x1bé",
+		want: []Token{
+			{Kind: TokenText, Raw: "a"},
+			{Kind: TokenText, Raw: "é"},
+		},
+	},
+	{
+		name: "esc intermediate aborted by rune",
+		in:   "\x1b(é",
+		want: []Token{{Kind: TokenText, Raw: "é"}},
+	},
+	{
+		name: "csi aborted by rune",
+		in:   "\x1b[1é",
+		want: []Token{{Kind: TokenText, Raw: "é"}},
+	},
+	{
+		name: "ss3 aborted",
+		in:   "\x1bOé\x1bO1",
+		want: []Token{
+			{Kind: TokenText, Raw: "é"},
+			{Kind: TokenText, Raw: "1"},
+		},
+	},
+	{
+		name: "osc terminated by bel",
+		in:   "\x1b]0;title\x07",
+		want: []Token{{Kind: TokenOSC, Raw: "\x1b]0;title\x07", Data: "0;title"}},
+	},
+	{
+		name: "osc terminated by st",
+		in:   "\x1b]8;;https://example.com\x1b\\link",
+		want: []Token{
+			{Kind: TokenOSC, Raw: "\x1b]8;;https://example.com\x1b\\", Data: "8;;https://example.com"},
+			{Kind: TokenText, Raw: "link"},
+		},
+	},
+	{
+		name: "osc interrupted",
+		in:   "\x1b]0;ti\x1b[1mx",
+		want: []Token{
+			{Kind: TokenOSC, Raw: "\x1b]0;ti", Data: "0;ti"},
+			{Kind: TokenCSI, Raw: "\x1b[1m", Final: 'm', Params: [][]int{{1}}},
+			{Kind: TokenText, Raw: "x"},
+		},
+	},
+	{
+		name: "dcs",
+		in:   "\x1bP1$rabc\x1b\\",
+		want: []Token{{
+			Kind:          TokenDCS,
+			Raw:           "\x1bP1$rabc\x1b\\",
+			Intermediates: "$",
+			Final:         'r',
+			Params:        [][]int{{1}},
+			Data:          "abc",
+		}},
+	},
+	{
+		name: "dcs interrupted",
+		in:   "\x1bPq#0\x1b[m",
+		want: []Token{
+			{Kind: TokenDCS, Raw: "\x1bPq#0", Final: 'q', Data: "#0"},
+			{Kind: TokenCSI, Raw: "\x1b[m", Final: 'm'},
+		},
+	},
+	{
+		name: "sos, pm and apc",
+		in:   "\x1bXa\x1b\\\x1b^b\x1b\\\x1b_c\x1b\\",
+		want: []Token{
+			{Kind: TokenSOS, Raw: "\x1bXa\x1b\\", Data: "a"},
+			{Kind: TokenPM, Raw: "\x1b^b\x1b\\", Data: "b"},
+			{Kind: TokenAPC, Raw: "\x1b_c\x1b\\", Data: "c"},
+		},
+	},
+	{
+		name: "can cancels csi",
+		in:   "\x1b[1;3\x18x",
+		want: []Token{
+			{Kind: TokenControl, Raw: "\x18"},
+			{Kind: TokenText, Raw: "x"},
+		},
+	},
+	{
+		name: "sub cancels osc",
+		in:   "\x1b]0;t\x1ax",
+		want: []Token{
+			{Kind: TokenControl, Raw: "\x1a"},
+			{Kind: TokenText, Raw: "x"},
+		},
+	},
+	{
+		name: "can cancels dcs",
+		in:   "\x1bPqab\x18x",
+		want: []Token{
+			{Kind: TokenControl, Raw: "\x18"},
+			{Kind: TokenText, Raw: "x"},
+		},
+	},
+	{
+		name: "incomplete sequence at the end",
+		in:   "ab\x1b[1",
+		want: []Token{{Kind: TokenText, Raw: "ab"}},
+	},
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range _ParseTests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Parse(test.in)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse(%q)\n got %#v\nwant %#v", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParserOneByte(t *testing.T) {
+	for _, test := range _ParseTests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewParser(iotest.OneByteReader(strings.NewReader(test.in)))
+
+			var got []Token
+			for {
+				tok, err := p.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next() error: %v", err)
+				}
+
+				if tok.Kind == TokenText && !utf8.ValidString(tok.Raw) {
+					t.Errorf("Next() split a rune: %q", tok.Raw)
+				}
+
+				got = append(got, tok)
+			}
+
+			// text may come in many tokens, join them back
+			got, want := _JoinText(got), _JoinText(test.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Parser(%q)\n got %#v\nwant %#v", test.in, got, want)
+			}
+		})
+	}
+}
+
+func _JoinText(toks []Token) []Token {
+	var joined []Token
+	for _, tok := range toks {
+		if n := len(joined); n > 0 && tok.Kind == TokenText && joined[n-1].Kind == TokenText {
+			joined[n-1].Raw += tok.Raw
+			continue
+		}
+
+		joined = append(joined, tok)
+	}
+
+	return joined
+}
+
+func TestParserSplitRune(t *testing.T) {
+	in := "é€😀\x1b[1m😀"
+	p := NewParser(iotest.OneByteReader(strings.NewReader(in)))
+
+	var text strings.Builder
+	for {
+		tok, err := p.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() error: %v", err)
+		}
+
+		if tok.Kind != TokenText {
+			continue
+		}
+
+		if !utf8.ValidString(tok.Raw) {
+			t.Errorf("Next() split a rune: %q", tok.Raw)
+		}
+		text.WriteString(tok.Raw)
+	}
+
+	if got, want := text.String(), "é€😀😀"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
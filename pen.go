@@ -3,8 +3,6 @@ package ansi
 import (
 	"fmt"
 	"io"
-	"math/bits"
-	"strings"
 )
 
 // Pen is a styled writer that can write text with various
@@ -13,121 +11,225 @@ import (
 // sequences.
 //
 // Writing is concurrent safe as long as the underlying
-// writer is concurrent safe. However, setting styles is
-// not concurrent safe. The pen may be copied to avoid this
-// issue, the styles will be copied as well.
+// writer is concurrent safe and the pen is not tracking the
+// terminal's style, see [Pen.SetTracking]. However, setting
+// styles is not concurrent safe. The pen may be copied to
+// avoid this issue, the styles will be copied as well,
+// including those saved by [Pen.Push].
 type Pen struct {
 	io.Writer // underlying writer
 
-	styles   byte // bitmask of styles
-	fg, bg   RGB  // foreground and background colors
-	disabled bool // disables the styling
+	style    Style   // current style
+	stack    []Style // styles saved by Push
+	term     Style   // style of the terminal, when tracking
+	tracking bool    // enables the tracking of the terminal's style
+	disabled bool    // disables the styling
 }
 
-const (
-	_BoldFlag = 1 << iota
-	_ItalicFlag
-	_UnderlineFlag
-	_StrikeFlag
-	_BGFlag
-	_FGFlag
-)
-
-var _ResetBytes = []byte(_Reset)
-
 // Style returns the current style as an escape sequence.
 // If no styles are set, it returns an reset escape sequence.
-func (p *Pen) Style() string {
-	var buf strings.Builder
-	buf.Grow(p._StyleCapNeeded())
-	buf.WriteString(_Csi)
+// Colors are downsampled according to the current [Profile].
+func (p *Pen) Style() string { return p.style.Sequence() }
 
-	if p.styles&_BoldFlag != 0 {
-		buf.Write([]byte{'1', ';'})
-	}
+// SetStyle defines, based on the on param, whether the
+// pen styles will be applied on writing.
+func (p *Pen) SetStyle(on bool) { p.disabled = !on }
 
-	if p.styles&_ItalicFlag != 0 {
-		buf.Write([]byte{'3', ';'})
+// SetTracking defines, based on the on param, whether the
+// pen tracks the style the terminal is currently in. When
+// tracking, writing does not reset the style afterwards, and
+// the next writing only emits the shortest sequence that
+// transitions from the style of the terminal to the style
+// of the pen, see [Transition]. Call [Pen.Restore] once done
+// writing to bring the terminal back to the default style.
+//
+// Only the pen should write styled text to the underlying
+// writer while tracking, otherwise the tracked style will not
+// match the terminal's.
+func (p *Pen) SetTracking(on bool) { p.tracking = on }
+
+// Restore brings the terminal back to the default style, if
+// the pen is tracking the terminal's style and it is not the
+// default already.
+func (p *Pen) Restore() error {
+	seq := Transition(p.term, Style{})
+	if seq == "" {
+		return nil
 	}
 
-	if p.styles&_UnderlineFlag != 0 {
-		buf.Write([]byte{'4', ';'})
+	if _, err := p.Writer.Write([]byte(seq)); err != nil {
+		return err
 	}
 
-	if p.styles&_StrikeFlag != 0 {
-		buf.Write([]byte{'9', ';'})
-	}
+	p.term = Style{}
+	return nil
+}
 
-	if p.styles&_BGFlag != 0 {
-		fmt.Fprintf(&buf, "48;2;%d;%d;%d;", p.bg.R, p.bg.G, p.bg.B)
-	}
+// Push saves the current style of the pen, so it can be
+// restored later by [Pen.Pop]. This allows for nested spans:
+// while there are saved styles, writing does not reset the
+// style afterwards, but restores the style saved last, so a
+// bold span written inside a red span leaves the text red,
+// but not bold, after it.
+//
+// Copies of the pen share no saved styles with it: pushing
+// or popping on a copy does not affect the original.
+func (p *Pen) Push() {
+	// clip the stack, so a copy never writes over the other's
+	n := len(p.stack)
+	p.stack = append(p.stack[:n:n], p.style)
+}
 
-	if p.styles&_FGFlag != 0 {
-		fmt.Fprintf(&buf, "38;2;%d;%d;%d;", p.fg.R, p.fg.G, p.fg.B)
+// Pop restores the style saved last by [Pen.Push]. If there
+// are no saved styles, it resets the style of the pen.
+func (p *Pen) Pop() {
+	n := len(p.stack)
+	if n == 0 {
+		p.style = Style{}
+		return
 	}
 
-	style := buf.String()[:buf.Len()-1]
-	return style + "m"
+	p.style = p.stack[n-1]
+	p.stack = p.stack[:n-1]
 }
 
-// SetStyle defines, based on the on param, whether the
-// pen styles will be applied on writing.
-func (p *Pen) SetStyle(on bool) { p.disabled = !on }
+// Use replaces the style of the pen by s.
+func (p *Pen) Use(s Style) { p.style = s }
 
 // Reset resets the style of the pen.
-func (p *Pen) Reset() { p.styles = 0 }
+func (p *Pen) Reset() { p.style = Style{} }
 
 // Bold applies the bold style.
-func (p *Pen) Bold() { p.styles |= _BoldFlag }
+func (p *Pen) Bold() { p.style = p.style.Bold() }
 
 // Italic applies the italic style.
-func (p *Pen) Italic() { p.styles |= _ItalicFlag }
+func (p *Pen) Italic() { p.style = p.style.Italic() }
 
 // Underline applies the underline style.
-func (p *Pen) Underline() { p.styles |= _UnderlineFlag }
+func (p *Pen) Underline() { p.style = p.style.Underline() }
 
 // Strike applies the strike style.
-func (p *Pen) Strike() { p.styles |= _StrikeFlag }
+func (p *Pen) Strike() { p.style = p.style.Strike() }
+
+// StyleUnderline applies an underline of the given style.
+// Unless enabled by [SetUnderlineStyles], it is emitted as
+// a single underline.
+func (p *Pen) StyleUnderline(s UnderlineStyle) { p.style = p.style.StyleUnderline(s) }
+
+// UnderlineColor applies an underline color.
+func (p *Pen) UnderlineColor(c Color) { p.style = p.style.UnderlineColor(c) }
+
+// Dim applies the dim style.
+func (p *Pen) Dim() { p.style = p.style.Dim() }
+
+// Blink applies the slow blink style.
+func (p *Pen) Blink() { p.style = p.style.Blink() }
+
+// RapidBlink applies the rapid blink style.
+func (p *Pen) RapidBlink() { p.style = p.style.RapidBlink() }
+
+// Reverse applies the reverse video style.
+func (p *Pen) Reverse() { p.style = p.style.Reverse() }
+
+// Conceal applies the conceal style.
+func (p *Pen) Conceal() { p.style = p.style.Conceal() }
+
+// DoubleUnderline applies the double underline style.
+func (p *Pen) DoubleUnderline() { p.style = p.style.DoubleUnderline() }
+
+// Overline applies the overline style.
+func (p *Pen) Overline() { p.style = p.style.Overline() }
+
+// Superscript applies the superscript style.
+func (p *Pen) Superscript() { p.style = p.style.Superscript() }
+
+// Subscript applies the subscript style.
+func (p *Pen) Subscript() { p.style = p.style.Subscript() }
 
 // BGColor applies a background color.
-func (p *Pen) BGColor(c Color) {
-	p.styles |= _BGFlag
-	r, g, b := c.RGB()
-	p.bg = RGB{r, g, b}
-}
+func (p *Pen) BGColor(c Color) { p.style = p.style.BG(c) }
 
 // FGColor applies a foreground color.
-func (p *Pen) FGColor(c Color) {
-	p.styles |= _FGFlag
-	r, g, b := c.RGB()
-	p.fg = RGB{r, g, b}
-}
+func (p *Pen) FGColor(c Color) { p.style = p.style.FG(c) }
+
+// Link turns the written text into a hyperlink that points
+// to the given link.
+func (p *Pen) Link(link string) { p.style = p.style.Link(link) }
 
 // UnBold unapplies the bold style.
-func (p *Pen) UnBold() { p.styles &^= _BoldFlag }
+func (p *Pen) UnBold() { p.style = p.style.UnBold() }
 
 // UnItalic unapplies the italic style.
-func (p *Pen) UnItalic() { p.styles &^= _ItalicFlag }
+func (p *Pen) UnItalic() { p.style = p.style.UnItalic() }
 
-// UnUnderline unapplies the underline style.
-func (p *Pen) UnUnderline() { p.styles &^= _UnderlineFlag }
+// UnUnderline unapplies the underline style, whatever its
+// style is.
+func (p *Pen) UnUnderline() { p.style = p.style.UnUnderline() }
 
 // UnStrike unapplies the strike style.
-func (p *Pen) UnStrike() { p.styles &^= _StrikeFlag }
+func (p *Pen) UnStrike() { p.style = p.style.UnStrike() }
 
 // UnBGColor unapplies the background color.
-func (p *Pen) UnBGColor(c Color) { p.styles &^= _BGFlag }
+func (p *Pen) UnBGColor(c Color) { p.style = p.style.UnBG() }
 
 // UnFGColor unapplies the foreground color.
-func (p *Pen) UnFGColor(c Color) { p.styles &^= _FGFlag }
+func (p *Pen) UnFGColor(c Color) { p.style = p.style.UnFG() }
+
+// UnUnderlineColor unapplies the underline color.
+func (p *Pen) UnUnderlineColor() { p.style = p.style.UnUnderlineColor() }
+
+// UnLink stops turning the written text into a hyperlink.
+func (p *Pen) UnLink() { p.style = p.style.UnLink() }
+
+// UnDim unapplies the dim style.
+func (p *Pen) UnDim() { p.style = p.style.UnDim() }
+
+// UnBlink unapplies the slow blink style.
+func (p *Pen) UnBlink() { p.style = p.style.UnBlink() }
+
+// UnRapidBlink unapplies the rapid blink style.
+func (p *Pen) UnRapidBlink() { p.style = p.style.UnRapidBlink() }
+
+// UnReverse unapplies the reverse video style.
+func (p *Pen) UnReverse() { p.style = p.style.UnReverse() }
+
+// UnConceal unapplies the conceal style.
+func (p *Pen) UnConceal() { p.style = p.style.UnConceal() }
+
+// UnDoubleUnderline unapplies the double underline style.
+func (p *Pen) UnDoubleUnderline() { p.style = p.style.UnDoubleUnderline() }
+
+// UnOverline unapplies the overline style.
+func (p *Pen) UnOverline() { p.style = p.style.UnOverline() }
+
+// UnSuperscript unapplies the superscript style.
+func (p *Pen) UnSuperscript() { p.style = p.style.UnSuperscript() }
+
+// UnSubscript unapplies the subscript style.
+func (p *Pen) UnSubscript() { p.style = p.style.UnSubscript() }
 
 // Write writes the given buffer to the underlying writer
 // with the current styles applied. It also appends a reset
-// sequence at the end to reset all styles.
+// sequence at the end to reset all styles, unless the pen
+// is tracking the terminal's style, or there are saved
+// styles, see [Pen.Push].
 func (p *Pen) Write(buf []byte) (int, error) {
-	if !p.disabled {
-		defer p.Writer.Write(_ResetBytes)
-		p.Writer.Write([]byte(p.Style()))
+	if p.tracking && !p.disabled {
+		seq := Transition(p.term, p.style)
+		if seq != "" {
+			if _, err := p.Writer.Write([]byte(seq)); err != nil {
+				return 0, err
+			}
+			p.term = p.style
+		}
+
+		return p.Writer.Write(buf)
+	}
+
+	if !p.disabled && (!p.style.IsZero() || len(p.stack) > 0) {
+		params := p.style._Params()
+		defer p.Writer.Write([]byte(p._Close(params)))
+		p.Writer.Write([]byte(p._Open(params)))
 	}
 
 	return p.Writer.Write(buf)
@@ -138,6 +240,7 @@ func (p *Pen) Write(buf []byte) (int, error) {
 func (p *Pen) Fprint(w io.Writer, a ...any) (int, error) {
 	pen := *p
 	pen.Writer = w
+	pen.tracking = false
 
 	return fmt.Fprint(&pen, a...)
 }
@@ -147,6 +250,7 @@ func (p *Pen) Fprint(w io.Writer, a ...any) (int, error) {
 func (p *Pen) Fprintf(w io.Writer, format string, a ...any) (int, error) {
 	pen := *p
 	pen.Writer = w
+	pen.tracking = false
 
 	return fmt.Fprintf(&pen, format, a...)
 }
@@ -156,6 +260,7 @@ func (p *Pen) Fprintf(w io.Writer, format string, a ...any) (int, error) {
 func (p *Pen) Fprintln(w io.Writer, a ...any) (int, error) {
 	pen := *p
 	pen.Writer = w
+	pen.tracking = false
 
 	return fmt.Fprintln(&pen, a...)
 }
@@ -181,13 +286,13 @@ func (p *Pen) Println(a ...any) (int, error) {
 // Sprint mimics their [fmt.Sprint] counterpart while wrapping the
 // output in the style of the pen.
 func (p *Pen) Sprint(a ...any) string {
-	return p.Style() + fmt.Sprint(a...) + _Reset
+	return p._Render(fmt.Sprint(a...))
 }
 
 // Sprintf mimics their [fmt.Sprintf] counterpart while wrapping the
 // output in the style of the pen.
 func (p *Pen) Sprintf(format string, a ...any) string {
-	return p.Style() + fmt.Sprintf(format, a...) + _Reset
+	return p._Render(fmt.Sprintf(format, a...))
 }
 
 // Sprintln mimics their [fmt.Sprintln] counterpart while wrapping
@@ -212,18 +317,138 @@ func (p *Pen) CursorRight(n int) { p.Writer.Write([]byte(CursorRight(n))) }
 // Note that both row and column are 0-indexed.
 func (p *Pen) MoveTo(r, c int) { p.Writer.Write([]byte(MoveTo(r, c))) }
 
-// ScrollUp scrolls the entire screen up by n rows.
+// CursorNextLine moves the cursor to the start of the line
+// n rows down.
+func (p *Pen) CursorNextLine(n int) { p.Writer.Write([]byte(CursorNextLine(n))) }
+
+// CursorPrevLine moves the cursor to the start of the line
+// n rows up.
+func (p *Pen) CursorPrevLine(n int) { p.Writer.Write([]byte(CursorPrevLine(n))) }
+
+// MoveToColumn moves the cursor to the given column of the
+// current row. Note that the column is 0-indexed.
+func (p *Pen) MoveToColumn(c int) { p.Writer.Write([]byte(MoveToColumn(c))) }
+
+// MoveToRow moves the cursor to the given row, keeping the
+// current column. Note that the row is 0-indexed.
+func (p *Pen) MoveToRow(r int) { p.Writer.Write([]byte(MoveToRow(r))) }
+
+// SaveCursor saves the position of the cursor, alongside
+// the current style of the terminal.
+func (p *Pen) SaveCursor() { p.Writer.Write([]byte(SaveCursor())) }
+
+// RestoreCursor restores the state saved by
+// [Pen.SaveCursor].
+func (p *Pen) RestoreCursor() { p.Writer.Write([]byte(RestoreCursor())) }
+
+// SavePosition saves only the position of the cursor.
+func (p *Pen) SavePosition() { p.Writer.Write([]byte(SavePosition())) }
+
+// RestorePosition restores the position saved by
+// [Pen.SavePosition].
+func (p *Pen) RestorePosition() { p.Writer.Write([]byte(RestorePosition())) }
+
+// TabForward moves the cursor forwards by n tab stops.
+func (p *Pen) TabForward(n int) { p.Writer.Write([]byte(TabForward(n))) }
+
+// TabBackward moves the cursor backwards by n tab stops.
+func (p *Pen) TabBackward(n int) { p.Writer.Write([]byte(TabBackward(n))) }
+
+// SetTabStop sets a tab stop at the column of the cursor.
+func (p *Pen) SetTabStop() { p.Writer.Write([]byte(SetTabStop())) }
+
+// ClearTabStop clears the tab stop at the column of the
+// cursor.
+func (p *Pen) ClearTabStop() { p.Writer.Write([]byte(ClearTabStop())) }
+
+// ClearAllTabStops clears every tab stop.
+func (p *Pen) ClearAllTabStops() { p.Writer.Write([]byte(ClearAllTabStops())) }
+
+// ScrollUp scrolls the screen, or the scroll region, up by
+// n rows.
 func (p *Pen) ScrollUp(n int) { p.Writer.Write([]byte(ScrollUp(n))) }
 
-// ScrollDown scrolls the entire screen down by n rows.
+// ScrollDown scrolls the screen, or the scroll region, down
+// by n rows.
 func (p *Pen) ScrollDown(n int) { p.Writer.Write([]byte(ScrollDown(n))) }
 
+// SetScrollRegion restricts scrolling to the rows from top
+// to bottom, both inclusive. Note that both are 0-indexed.
+func (p *Pen) SetScrollRegion(top, bottom int) { p.Writer.Write([]byte(SetScrollRegion(top, bottom))) }
+
+// ResetScrollRegion makes the scroll region span the entire
+// screen.
+func (p *Pen) ResetScrollRegion() { p.Writer.Write([]byte(ResetScrollRegion())) }
+
+// EnterMarginMode enables the left and right margins.
+func (p *Pen) EnterMarginMode() { p.Writer.Write([]byte(EnterMarginMode())) }
+
+// LeaveMarginMode disables the left and right margins.
+func (p *Pen) LeaveMarginMode() { p.Writer.Write([]byte(LeaveMarginMode())) }
+
+// SetMargins restricts the scroll region to the columns
+// from left to right, both inclusive. Note that both are
+// 0-indexed.
+func (p *Pen) SetMargins(left, right int) { p.Writer.Write([]byte(SetMargins(left, right))) }
+
+// ResetMargins makes the scroll region span every column.
+func (p *Pen) ResetMargins() { p.Writer.Write([]byte(ResetMargins())) }
+
+// EnterOriginMode makes the positions of the cursor
+// relative to the scroll region.
+func (p *Pen) EnterOriginMode() { p.Writer.Write([]byte(EnterOriginMode())) }
+
+// LeaveOriginMode makes the positions of the cursor
+// relative to the screen.
+func (p *Pen) LeaveOriginMode() { p.Writer.Write([]byte(LeaveOriginMode())) }
+
 // EraseScreen erases the entire screen.
 func (p *Pen) EraseScreen() { p.Writer.Write([]byte(EraseScreen())) }
 
 // EraseLine erases the entire current line.
 func (p *Pen) EraseLine() { p.Writer.Write([]byte(EraseLine())) }
 
+// EraseToEndOfScreen erases from the cursor to the end of
+// the screen.
+func (p *Pen) EraseToEndOfScreen() { p.Writer.Write([]byte(EraseToEndOfScreen())) }
+
+// EraseToStartOfScreen erases from the start of the screen
+// to the cursor.
+func (p *Pen) EraseToStartOfScreen() { p.Writer.Write([]byte(EraseToStartOfScreen())) }
+
+// EraseScrollback erases the scrollback buffer.
+func (p *Pen) EraseScrollback() { p.Writer.Write([]byte(EraseScrollback())) }
+
+// EraseToEndOfLine erases from the cursor to the end of the
+// current line.
+func (p *Pen) EraseToEndOfLine() { p.Writer.Write([]byte(EraseToEndOfLine())) }
+
+// EraseToStartOfLine erases from the start of the current
+// line to the cursor.
+func (p *Pen) EraseToStartOfLine() { p.Writer.Write([]byte(EraseToStartOfLine())) }
+
+// EraseChars erases n characters from the cursor onwards.
+func (p *Pen) EraseChars(n int) { p.Writer.Write([]byte(EraseChars(n))) }
+
+// InsertLines inserts n blank lines at the cursor's row,
+// pushing the rows below down.
+func (p *Pen) InsertLines(n int) { p.Writer.Write([]byte(InsertLines(n))) }
+
+// DeleteLines deletes n lines from the cursor's row
+// downwards, pulling the rows below up.
+func (p *Pen) DeleteLines(n int) { p.Writer.Write([]byte(DeleteLines(n))) }
+
+// InsertChars inserts n blanks at the cursor, pushing the
+// rest of the line to the right.
+func (p *Pen) InsertChars(n int) { p.Writer.Write([]byte(InsertChars(n))) }
+
+// DeleteChars deletes n characters from the cursor onwards,
+// pulling the rest of the line to the left.
+func (p *Pen) DeleteChars(n int) { p.Writer.Write([]byte(DeleteChars(n))) }
+
+// Repeat repeats the last printed character n more times.
+func (p *Pen) Repeat(n int) { p.Writer.Write([]byte(Repeat(n))) }
+
 // StyleCursor sets the cursor style to the given style.
 func (p *Pen) StyleCursor(s CursorStyle) { p.Writer.Write([]byte(StyleCursor(s))) }
 
@@ -248,16 +473,55 @@ func (p *Pen) EnterBracketedPaste() { p.Writer.Write([]byte(EnterBracketedPaste(
 // paste mode.
 func (p *Pen) LeaveBracketedPaste() { p.Writer.Write([]byte(LeaveBracketedPaste())) }
 
-func (p *Pen) _StyleCapNeeded() int {
-	const style_mask = _BoldFlag | _ItalicFlag | _UnderlineFlag | _StrikeFlag
-	const ground_mask = _BGFlag | _FGFlag
+// _Render wraps text in the style of the pen, restoring the
+// style saved last, if any, afterwards.
+func (p *Pen) _Render(text string) string {
+	if len(p.stack) == 0 {
+		return p.style.Render(text)
+	}
+
+	params := p.style._Params()
+	return p._Open(params) + text + p._Close(params)
+}
+
+// _Open returns the sequences that start a span written by the
+// pen, whose style has the parameters params. While there are
+// saved styles, a previous span may have left the terminal in any
+// of them, so the style is set over a reset.
+func (p *Pen) _Open(params []string) string {
+	if len(p.stack) == 0 {
+		return p.style._Open(params)
+	}
 
-	const style_flag_size = 2
-	const ground_flag_size = 14
+	var open string
+	if p.style.link != "" {
+		open = fmt.Sprintf(_LinkOpen, p.style.link)
+	} else if p._Linked() {
+		open = _LinkClose
+	}
+
+	return open + p.style._Sequence("0;", params)
+}
 
-	cap := len(_Csi)
-	cap += style_flag_size * bits.OnesCount(uint(p.styles&style_mask))
-	cap += ground_flag_size * bits.OnesCount(uint(p.styles&ground_mask))
+// _Linked reports whether any of the saved styles has a hyperlink.
+func (p *Pen) _Linked() bool {
+	for _, s := range p.stack {
+		if s.link != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// _Close returns the sequences that end a span written by the
+// pen, that is, those that bring the terminal back to the style
+// saved last, if any, or to the default style otherwise. The
+// style of the pen has the parameters params.
+func (p *Pen) _Close(params []string) string {
+	if n := len(p.stack); n > 0 {
+		return Transition(p.style, p.stack[n-1])
+	}
 
-	return cap
+	return p.style._Close(params)
 }
@@ -0,0 +1,24 @@
+package ansi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPenWriteAfterUse(t *testing.T) {
+	var buf strings.Builder
+	p := Pen{Writer: &buf}
+
+	p.FGColor(Red)
+	p.Push()
+	p.Bold()
+	p.Write([]byte("a"))
+	p.Use(Style{}.Italic())
+	p.Write([]byte("b"))
+
+	got := buf.String()
+	want := "\x1b[0;1;31ma\x1b[22m\x1b[0;3mb\x1b[0;31m"
+	if got != want {
+		t.Errorf("Write() = %q, want %q", got, want)
+	}
+}
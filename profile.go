@@ -0,0 +1,86 @@
+package ansi
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Profile defines the color depth supported by a terminal. Colors
+// are downsampled to the nearest color supported by the current
+// profile, see [SetProfile].
+type Profile int32
+
+const (
+	ProfileTrueColor Profile = iota // 24-bit colors
+	ProfileANSI256                  // xterm 256 color palette
+	ProfileANSI16                   // basic 16 color palette
+	ProfileNoColor                  // no colors at all
+)
+
+var _CurrentProfile atomic.Int32
+
+// SetProfile sets the color depth used by every emitter of this
+// package, that is, the top-level functions, [Builder] and [Pen].
+// Under [ProfileNoColor], color sequences are omitted, but other
+// styles, like bold, are still emitted. The default profile is
+// [ProfileTrueColor]. It is safe to call SetProfile concurrently.
+func SetProfile(p Profile) { _CurrentProfile.Store(int32(p)) }
+
+// CurrentProfile returns the color depth set by [SetProfile].
+func CurrentProfile() Profile { return Profile(_CurrentProfile.Load()) }
+
+// _ColorParams returns the SGR parameters that set the color of
+// the given ground, one of [_Foreground], [_Background] and
+// [_Underground], to c, downsampled according to the current
+// profile. Under [ProfileNoColor], it returns an empty string.
+//
+// Indexed colors are emitted as such, [ANSI16] colors are never
+// downsampled, while [ANSI256] colors are only downsampled under
+// [ProfileANSI16].
+func _ColorParams(c Color, ground int) string {
+	profile := CurrentProfile()
+	if profile == ProfileNoColor {
+		return ""
+	}
+
+	switch c := c.(type) {
+	case ANSI16:
+		return _ANSI16Params(uint8(c&0xF), ground)
+
+	case ANSI256:
+		if profile == ProfileANSI16 {
+			if c < 16 {
+				return _ANSI16Params(uint8(c), ground)
+			}
+			return _ANSI16Params(_NearestANSI16(RGBFromColor(c)), ground)
+		}
+		return fmt.Sprintf(_IndexedColor, ground, c)
+	}
+
+	switch profile {
+	case ProfileANSI16:
+		return _ANSI16Params(_NearestANSI16(RGBFromColor(c)), ground)
+	case ProfileANSI256:
+		return fmt.Sprintf(_IndexedColor, ground, _NearestANSI256(RGBFromColor(c)))
+	}
+
+	R, G, B := c.RGB()
+	return fmt.Sprintf(_DirectColor, ground, R, G, B)
+}
+
+// _ANSI16Params returns the SGR parameters that set the color of
+// the given ground to the i-th color of the basic palette. There
+// are no such parameters for the underline color, so the xterm 256
+// color palette, which contains the basic one, is used instead.
+func _ANSI16Params(i uint8, ground int) string {
+	if ground == _Underground {
+		return fmt.Sprintf(_IndexedColor, ground, i)
+	}
+
+	code := int(i)
+	if code >= 8 {
+		code += 60 - 8
+	}
+
+	return fmt.Sprint(ground - 8 + code)
+}
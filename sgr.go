@@ -0,0 +1,461 @@
+package ansi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Style is an immutable set of text attributes, colors and
+// hyperlink. Its methods return modified copies instead of
+// mutating the style, so they can be chained, and styles can be
+// declared once and shared across goroutines safely:
+//
+//	var Warning = ansi.Style{}.Bold().FG(ansi.Yellow)
+//
+//	fmt.Println(Warning.Render("warning:"), "disk almost full")
+//
+// The zero-value is the default style, with no attributes set.
+// Styles are comparable with the == operator.
+type Style struct {
+	attrs       uint32         // bitmask of attributes
+	fg, bg, ulc Color          // colors, nil if unset
+	ul          UnderlineStyle // underline style, if not single
+	link        string         // hyperlink target
+}
+
+const (
+	_BoldFlag = 1 << iota
+	_ItalicFlag
+	_UnderlineFlag
+	_StrikeFlag
+	_DimFlag
+	_BlinkFlag
+	_RapidBlinkFlag
+	_ReverseFlag
+	_ConcealFlag
+	_DoubleUnderlineFlag
+	_OverlineFlag
+	_SuperscriptFlag
+	_SubscriptFlag
+)
+
+// _StyleCodes maps each attribute flag to its SGR parameter, in
+// the order they are emitted.
+var _StyleCodes = [...]struct {
+	flag uint32
+	code string
+}{
+	{_BoldFlag, "1"},
+	{_DimFlag, "2"},
+	{_ItalicFlag, "3"},
+	{_UnderlineFlag, "4"},
+	{_BlinkFlag, "5"},
+	{_RapidBlinkFlag, "6"},
+	{_ReverseFlag, "7"},
+	{_ConcealFlag, "8"},
+	{_StrikeFlag, "9"},
+	{_DoubleUnderlineFlag, "21"},
+	{_OverlineFlag, "53"},
+	{_SuperscriptFlag, "73"},
+	{_SubscriptFlag, "74"},
+}
+
+// StyleFromPen returns the current style of the pen.
+func StyleFromPen(p *Pen) Style { return p.style }
+
+// Bold returns the style with the bold attribute.
+func (s Style) Bold() Style { return s._With(_BoldFlag) }
+
+// Dim returns the style with the dim attribute.
+func (s Style) Dim() Style { return s._With(_DimFlag) }
+
+// Italic returns the style with the italic attribute.
+func (s Style) Italic() Style { return s._With(_ItalicFlag) }
+
+// Underline returns the style with the underline attribute.
+func (s Style) Underline() Style { return s._With(_UnderlineFlag) }
+
+// StyleUnderline returns the style with an underline of the
+// given style. Unless enabled by [SetUnderlineStyles], it is
+// emitted as a single underline.
+func (s Style) StyleUnderline(u UnderlineStyle) Style {
+	if u <= UnderlineNone {
+		return s.UnUnderline()
+	}
+
+	s.attrs |= _UnderlineFlag
+	s.ul = u
+	return s
+}
+
+// Blink returns the style with the slow blink attribute.
+func (s Style) Blink() Style { return s._With(_BlinkFlag) }
+
+// RapidBlink returns the style with the rapid blink attribute.
+func (s Style) RapidBlink() Style { return s._With(_RapidBlinkFlag) }
+
+// Reverse returns the style with the reverse video attribute.
+func (s Style) Reverse() Style { return s._With(_ReverseFlag) }
+
+// Conceal returns the style with the conceal attribute.
+func (s Style) Conceal() Style { return s._With(_ConcealFlag) }
+
+// Strike returns the style with the strike attribute.
+func (s Style) Strike() Style { return s._With(_StrikeFlag) }
+
+// DoubleUnderline returns the style with the double underline
+// attribute.
+func (s Style) DoubleUnderline() Style { return s._With(_DoubleUnderlineFlag) }
+
+// Overline returns the style with the overline attribute.
+func (s Style) Overline() Style { return s._With(_OverlineFlag) }
+
+// Superscript returns the style with the superscript attribute.
+func (s Style) Superscript() Style { return s._With(_SuperscriptFlag) }
+
+// Subscript returns the style with the subscript attribute.
+func (s Style) Subscript() Style { return s._With(_SubscriptFlag) }
+
+// FG returns the style with the given foreground color.
+func (s Style) FG(c Color) Style { s.fg = _Canonical(c); return s }
+
+// BG returns the style with the given background color.
+func (s Style) BG(c Color) Style { s.bg = _Canonical(c); return s }
+
+// UnderlineColor returns the style with the given underline
+// color.
+func (s Style) UnderlineColor(c Color) Style { s.ulc = _Canonical(c); return s }
+
+// Link returns the style turned into a hyperlink pointing to the
+// given link.
+func (s Style) Link(link string) Style { s.link = link; return s }
+
+// UnBold returns the style without the bold attribute.
+func (s Style) UnBold() Style { return s._Without(_BoldFlag) }
+
+// UnDim returns the style without the dim attribute.
+func (s Style) UnDim() Style { return s._Without(_DimFlag) }
+
+// UnItalic returns the style without the italic attribute.
+func (s Style) UnItalic() Style { return s._Without(_ItalicFlag) }
+
+// UnUnderline returns the style without the underline
+// attribute, whatever its style is.
+func (s Style) UnUnderline() Style {
+	s.attrs &^= _UnderlineFlag
+	s.ul = UnderlineNone
+	return s
+}
+
+// UnBlink returns the style without the slow blink attribute.
+func (s Style) UnBlink() Style { return s._Without(_BlinkFlag) }
+
+// UnRapidBlink returns the style without the rapid blink
+// attribute.
+func (s Style) UnRapidBlink() Style { return s._Without(_RapidBlinkFlag) }
+
+// UnReverse returns the style without the reverse video
+// attribute.
+func (s Style) UnReverse() Style { return s._Without(_ReverseFlag) }
+
+// UnConceal returns the style without the conceal attribute.
+func (s Style) UnConceal() Style { return s._Without(_ConcealFlag) }
+
+// UnStrike returns the style without the strike attribute.
+func (s Style) UnStrike() Style { return s._Without(_StrikeFlag) }
+
+// UnDoubleUnderline returns the style without the double
+// underline attribute.
+func (s Style) UnDoubleUnderline() Style { return s._Without(_DoubleUnderlineFlag) }
+
+// UnOverline returns the style without the overline attribute.
+func (s Style) UnOverline() Style { return s._Without(_OverlineFlag) }
+
+// UnSuperscript returns the style without the superscript
+// attribute.
+func (s Style) UnSuperscript() Style { return s._Without(_SuperscriptFlag) }
+
+// UnSubscript returns the style without the subscript
+// attribute.
+func (s Style) UnSubscript() Style { return s._Without(_SubscriptFlag) }
+
+// UnFG returns the style with the default foreground color.
+func (s Style) UnFG() Style { s.fg = nil; return s }
+
+// UnBG returns the style with the default background color.
+func (s Style) UnBG() Style { s.bg = nil; return s }
+
+// UnUnderlineColor returns the style with the default underline
+// color.
+func (s Style) UnUnderlineColor() Style { s.ulc = nil; return s }
+
+// UnLink returns the style without the hyperlink.
+func (s Style) UnLink() Style { s.link = ""; return s }
+
+// Inherit returns the style with the properties it does not set
+// taken from parent. Attributes are combined, while colors,
+// underline style and hyperlink are only taken from parent if
+// unset.
+func (s Style) Inherit(parent Style) Style {
+	if s.attrs&_UnderlineFlag == 0 {
+		s.ul = parent.ul
+	}
+
+	s.attrs |= parent.attrs
+
+	if s.fg == nil {
+		s.fg = parent.fg
+	}
+
+	if s.bg == nil {
+		s.bg = parent.bg
+	}
+
+	if s.ulc == nil {
+		s.ulc = parent.ulc
+	}
+
+	if s.link == "" {
+		s.link = parent.link
+	}
+
+	return s
+}
+
+// Merge returns the style with the properties set by other
+// applied over it. It is the same as other.Inherit(s).
+func (s Style) Merge(other Style) Style { return other.Inherit(s) }
+
+// Equal reports whether s and other are the same style. It is
+// the same as s == other.
+func (s Style) Equal(other Style) bool { return s == other }
+
+// IsZero reports whether s is the default style.
+func (s Style) IsZero() bool { return s == Style{} }
+
+// Sequence returns the style as a graphic rendition escape
+// sequence, leaving the hyperlink aside. If no attributes nor
+// colors are emitted, it returns a reset escape sequence. Colors
+// are downsampled according to the current [Profile], so they are
+// not emitted under [ProfileNoColor].
+func (s Style) Sequence() string { return s._Sequence("", s._Params()) }
+
+// Render returns text wrapped in the style, that is, preceded by
+// the sequences that apply the style and followed by those that
+// undo it. If s is the default style, text is returned as is.
+func (s Style) Render(text string) string {
+	if s.IsZero() {
+		return text
+	}
+
+	params := s._Params()
+	return s._Open(params) + text + s._Close(params)
+}
+
+// _Sequence returns the graphic rendition escape sequence that sets
+// params, the parameters of the style, preceded by prefix. If there
+// are no parameters, it returns a reset escape sequence.
+func (s Style) _Sequence(prefix string, params []string) string {
+	if len(params) == 0 {
+		return _Reset
+	}
+
+	seq := _Csi + prefix + strings.Join(params, ";") + "m"
+	if s._Underline() > UnderlineSingle {
+		seq += fmt.Sprintf(_StyleUnderline, s.ul)
+	}
+
+	return seq
+}
+
+func (s Style) _With(flag uint32) Style    { s.attrs |= flag; return s }
+func (s Style) _Without(flag uint32) Style { s.attrs &^= flag; return s }
+
+// _Params returns the SGR parameters of the style, without the
+// underline style.
+func (s Style) _Params() []string {
+	params := make([]string, 0, len(_StyleCodes)+3)
+	for _, style := range _StyleCodes {
+		if s.attrs&style.flag != 0 {
+			params = append(params, style.code)
+		}
+	}
+
+	for _, ground := range [...]struct {
+		c    Color
+		code int
+	}{
+		{s.bg, _Background},
+		{s.fg, _Foreground},
+		{s.ulc, _Underground},
+	} {
+		if ground.c == nil {
+			continue
+		}
+
+		if param := _ColorParams(ground.c, ground.code); param != "" {
+			params = append(params, param)
+		}
+	}
+
+	return params
+}
+
+// _Open returns the sequences that bring a terminal from its
+// default state to the style, whose parameters are params.
+func (s Style) _Open(params []string) string {
+	var open string
+	if s.link != "" {
+		open = fmt.Sprintf(_LinkOpen, s.link)
+	}
+
+	if len(params) > 0 {
+		open += s._Sequence("", params)
+	}
+
+	return open
+}
+
+// _Close returns the sequences that bring a terminal from the
+// style, whose parameters are params, back to its default state.
+// Colors alone have no parameters under [ProfileNoColor], so
+// there may be nothing to reset.
+func (s Style) _Close(params []string) string {
+	var close string
+	if len(params) > 0 {
+		close = _Reset
+	}
+
+	if s.link != "" {
+		close += _LinkClose
+	}
+
+	return close
+}
+
+// _AttrGroups groups the attribute flags by the SGR parameter that
+// disables them, in the order they are emitted in transitions.
+var _AttrGroups = [...]struct {
+	mask uint32
+	off  string
+}{
+	{_BoldFlag | _DimFlag, "22"},
+	{_ItalicFlag, "23"},
+	{_UnderlineFlag | _DoubleUnderlineFlag, "24"},
+	{_BlinkFlag | _RapidBlinkFlag, "25"},
+	{_ReverseFlag, "27"},
+	{_ConcealFlag, "28"},
+	{_StrikeFlag, "29"},
+	{_OverlineFlag, "55"},
+	{_SuperscriptFlag | _SubscriptFlag, "75"},
+}
+
+// Transition returns the shortest sequence that brings a terminal
+// whose current style is from to the style to, which is either a
+// sequence that only changes what differs between the styles, or a
+// reset followed by the whole style to, whichever is shorter. If
+// both styles are equal, it returns an empty string.
+func Transition(from, to Style) string {
+	var seq string
+	if from.link != to.link {
+		if to.link != "" {
+			seq = fmt.Sprintf(_LinkOpen, to.link)
+		} else {
+			seq = _LinkClose
+		}
+	}
+
+	from.link, to.link = "", ""
+	if from == to {
+		return seq
+	}
+
+	var diff string
+	if params := _DiffParams(from, to); len(params) > 0 {
+		diff = _Csi + strings.Join(params, ";") + "m"
+	}
+
+	reset := _Reset
+	if params := to._Params(); len(params) > 0 {
+		reset = _Csi + "0;" + strings.Join(params, ";") + "m"
+	}
+
+	if len(from._Params()) > 0 && len(reset) < len(diff) {
+		diff = reset
+		from = Style{}
+	}
+
+	if to._Underline() > UnderlineSingle && to._Underline() != from._Underline() {
+		diff += fmt.Sprintf(_StyleUnderline, to.ul)
+	}
+
+	return seq + diff
+}
+
+// _DiffParams returns the SGR parameters that change the style
+// from into the style to.
+func _DiffParams(from, to Style) []string {
+	var params []string
+	for _, group := range _AttrGroups {
+		f, t := from.attrs&group.mask, to.attrs&group.mask
+		add := t &^ f
+
+		if group.mask&_UnderlineFlag != 0 && from._Underline() != to._Underline() {
+			add |= t & _UnderlineFlag
+		}
+
+		if f&^t != 0 {
+			params = append(params, group.off)
+			add = t
+		}
+
+		for _, style := range _StyleCodes {
+			if add&style.flag != 0 {
+				params = append(params, style.code)
+			}
+		}
+	}
+
+	for _, ground := range [...]struct {
+		from, to Color
+		code     int
+		off      string
+	}{
+		{from.bg, to.bg, _Background, "49"},
+		{from.fg, to.fg, _Foreground, "39"},
+		{from.ulc, to.ulc, _Underground, "59"},
+	} {
+		var f, t string
+		if ground.from != nil {
+			f = _ColorParams(ground.from, ground.code)
+		}
+
+		if ground.to != nil {
+			t = _ColorParams(ground.to, ground.code)
+		}
+
+		switch {
+		case f == t:
+		case t == "":
+			params = append(params, ground.off)
+		default:
+			params = append(params, t)
+		}
+	}
+
+	return params
+}
+
+// _Underline returns the effective underline style of s, which is
+// single if the styles of the underline are not emitted.
+func (s Style) _Underline() UnderlineStyle {
+	if s.attrs&_UnderlineFlag == 0 {
+		return UnderlineNone
+	}
+
+	if !UnderlineStyles() {
+		return UnderlineSingle
+	}
+
+	return max(s.ul, UnderlineSingle)
+}
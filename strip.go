@@ -0,0 +1,74 @@
+package ansi
+
+import (
+	"io"
+	"strings"
+)
+
+// StripANSI returns s with all of its escape sequences removed,
+// including CSI, OSC (and therefore hyperlinks), DCS, SOS, PM and
+// APC strings. Plain text and control characters, like line feeds
+// and tabs, are kept.
+func StripANSI(s string) string {
+	var buf strings.Builder
+	buf.Grow(len(s))
+
+	for _, t := range Parse(s) {
+		if t.Kind == TokenText || t.Kind == TokenControl {
+			buf.WriteString(t.Raw)
+		}
+	}
+
+	return buf.String()
+}
+
+// StripWriter is a writer that removes all escape sequences from
+// what is written to it before passing it to the underlying
+// writer, see [StripANSI]. Sequences may be split across multiple
+// writes.
+//
+// The zero-value, given an underlying writer, is ready to use.
+// Writing is not concurrent safe.
+type StripWriter struct {
+	io.Writer // underlying writer
+
+	m   _Machine
+	buf []byte
+}
+
+// Write writes buf, without escape sequences, to the underlying
+// writer. It returns len(buf) if no error occurred, even if less
+// bytes were written to the underlying writer.
+func (w *StripWriter) Write(buf []byte) (int, error) {
+	w.buf = w.buf[:0]
+	w.m.Feed(buf, w._Keep)
+
+	if len(w.buf) > 0 {
+		if _, err := w.Writer.Write(w.buf); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(buf), nil
+}
+
+// Flush writes any pending text to the underlying writer and
+// discards any incomplete escape sequence. It should be called
+// once the writing is done.
+func (w *StripWriter) Flush() error {
+	w.buf = w.buf[:0]
+	w.m.Flush(w._Keep)
+
+	if len(w.buf) > 0 {
+		_, err := w.Writer.Write(w.buf)
+		return err
+	}
+
+	return nil
+}
+
+func (w *StripWriter) _Keep(t Token) {
+	if t.Kind == TokenText || t.Kind == TokenControl {
+		w.buf = append(w.buf, t.Raw...)
+	}
+}
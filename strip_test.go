@@ -0,0 +1,46 @@
+package ansi
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+type _RecordWriter struct{ writes []string }
+
+func (w *_RecordWriter) Write(p []byte) (int, error) {
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
+
+func TestStripWriterOneByte(t *testing.T) {
+	in := "\x1b[1;31merror:\x1b[m café \x1b]8;;https://x\x1b\\😀\x1b]8;;\x1b\\\x1bP1$rq\x1b\\\n"
+	want := "error: café 😀\n"
+
+	var rec _RecordWriter
+	w := StripWriter{Writer: &rec}
+
+	for i := range len(in) {
+		if n, err := w.Write([]byte{in[i]}); n != 1 || err != nil {
+			t.Fatalf("Write() = %d, %v, want 1, nil", n, err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error: %v", err)
+	}
+
+	for _, s := range rec.writes {
+		if !utf8.ValidString(s) {
+			t.Errorf("StripWriter wrote a split rune: %q", s)
+		}
+	}
+
+	if got := strings.Join(rec.writes, ""); got != want {
+		t.Errorf("StripWriter wrote %q, want %q", got, want)
+	}
+
+	if got := StripANSI(in); got != want {
+		t.Errorf("StripANSI(%q) = %q, want %q", in, got, want)
+	}
+}
@@ -1,6 +1,9 @@
 package ansi
 
-import "fmt"
+import (
+	"fmt"
+	"sync/atomic"
+)
 
 const (
 	_Reset = _Csi + "m"
@@ -10,19 +13,45 @@ const (
 	_Underline = _Csi + "4m"
 	_Strike    = _Csi + "9m"
 
+	_Dim             = _Csi + "2m"
+	_Blink           = _Csi + "5m"
+	_RapidBlink      = _Csi + "6m"
+	_Reverse         = _Csi + "7m"
+	_Conceal         = _Csi + "8m"
+	_DoubleUnderline = _Csi + "21m"
+	_Overline        = _Csi + "53m"
+	_Superscript     = _Csi + "73m"
+	_Subscript       = _Csi + "74m"
+
 	_UnBold      = _Csi + "22m"
 	_UnItalic    = _Csi + "23m"
 	_UnUnderline = _Csi + "24m"
 	_UnStrike    = _Csi + "29m"
 
-	_FGColor = _Csi + "38;2;%d;%d;%dm"
-	_BGColor = _Csi + "48;2;%d;%d;%dm"
+	_UnDim      = _Csi + "22m"
+	_UnBlink    = _Csi + "25m"
+	_UnReverse  = _Csi + "27m"
+	_UnConceal  = _Csi + "28m"
+	_UnOverline = _Csi + "55m"
+	_UnScript   = _Csi + "75m"
+
+	_Foreground  = 38
+	_Background  = 48
+	_Underground = 58
+
+	_DirectColor  = "%d;2;%d;%d;%d"
+	_IndexedColor = "%d;5;%d"
 
 	_UnFGColor = _Csi + "39m"
 	_UnBGColor = _Csi + "49m"
 
+	_StyleUnderline   = _Csi + "4:%dm"
+	_UnUnderlineColor = _Csi + "59m"
+
 	_St        = _Esc + "\\"
-	_HyperLink = _Osc + "8;;%s" + _St + "%s" + _Osc + "8;;" + _St
+	_LinkOpen  = _Osc + "8;;%s" + _St
+	_LinkClose = _Osc + "8;;" + _St
+	_HyperLink = _LinkOpen + "%s" + _LinkClose
 )
 
 // Reset returns an escape sequence that can reset all the
@@ -45,18 +74,65 @@ func Underline() string { return _Underline }
 // the text.
 func Strike() string { return _Strike }
 
+// Dim returns an escape sequence that can decrease the
+// intensity of the text.
+func Dim() string { return _Dim }
+
+// Blink returns an escape sequence that can make the text
+// blink slowly.
+func Blink() string { return _Blink }
+
+// RapidBlink returns an escape sequence that can make the
+// text blink rapidly. Not widely supported.
+func RapidBlink() string { return _RapidBlink }
+
+// Reverse returns an escape sequence that can swap the
+// foreground and background colors of the text.
+func Reverse() string { return _Reverse }
+
+// Conceal returns an escape sequence that can hide the
+// text.
+func Conceal() string { return _Conceal }
+
+// DoubleUnderline returns an escape sequence that can put a
+// double underline on the text. Some terminals interpret it
+// as disabling bold instead.
+func DoubleUnderline() string { return _DoubleUnderline }
+
+// Overline returns an escape sequence that can put an
+// overline on the text.
+func Overline() string { return _Overline }
+
+// Superscript returns an escape sequence that can turn the
+// text into superscript. Supported by very few terminals.
+func Superscript() string { return _Superscript }
+
+// Subscript returns an escape sequence that can turn the
+// text into subscript. Supported by very few terminals.
+func Subscript() string { return _Subscript }
+
 // BGColor returns an escape sequence that can set the
-// background color of the text to the given color.
+// background color of the text to the given color. The color
+// is downsampled according to the current [Profile], and under
+// [ProfileNoColor], an empty string is returned.
 func BGColor(c Color) string {
-	R, G, B := c.RGB()
-	return fmt.Sprintf(_BGColor, R, G, B)
+	params := _ColorParams(c, _Background)
+	if params == "" {
+		return ""
+	}
+	return _Csi + params + "m"
 }
 
 // FGColor returns an escape sequence that can set the
-// foreground color of the text to the given color.
+// foreground color of the text to the given color. The color
+// is downsampled according to the current [Profile], and under
+// [ProfileNoColor], an empty string is returned.
 func FGColor(c Color) string {
-	R, G, B := c.RGB()
-	return fmt.Sprintf(_FGColor, R, G, B)
+	params := _ColorParams(c, _Foreground)
+	if params == "" {
+		return ""
+	}
+	return _Csi + params + "m"
 }
 
 // UnBold returns an escape sequence that can restore the
@@ -83,6 +159,105 @@ func UnBGColor() string   { return _UnBGColor }
 // foreground color to the default.
 func UnFGColor() string   { return _UnFGColor }
 
+// UnDim returns an escape sequence that can restore the
+// intensity of the text. The same as [UnBold].
+func UnDim() string { return _UnDim }
+
+// UnBlink returns an escape sequence that can disable
+// blinking, both slow and rapid.
+func UnBlink() string { return _UnBlink }
+
+// UnRapidBlink returns an escape sequence that can disable
+// blinking, both slow and rapid. The same as [UnBlink].
+func UnRapidBlink() string { return _UnBlink }
+
+// UnReverse returns an escape sequence that can restore the
+// foreground and background colors to their places.
+func UnReverse() string { return _UnReverse }
+
+// UnConceal returns an escape sequence that can reveal the
+// text.
+func UnConceal() string { return _UnConceal }
+
+// UnDoubleUnderline returns an escape sequence that can
+// disable underline, double or not. The same as
+// [UnUnderline].
+func UnDoubleUnderline() string { return _UnUnderline }
+
+// UnOverline returns an escape sequence that can disable
+// overline.
+func UnOverline() string { return _UnOverline }
+
+// UnSuperscript returns an escape sequence that can disable
+// both superscript and subscript.
+func UnSuperscript() string { return _UnScript }
+
+// UnSubscript returns an escape sequence that can disable
+// both superscript and subscript. The same as
+// [UnSuperscript].
+func UnSubscript() string { return _UnScript }
+
+// UnderlineStyle defines the style of the underline.
+type UnderlineStyle int
+
+const (
+	UnderlineNone UnderlineStyle = iota
+	UnderlineSingle
+	UnderlineDouble
+	UnderlineCurly
+	UnderlineDotted
+	UnderlineDashed
+)
+
+var _UnderlineStyles atomic.Bool
+
+// SetUnderlineStyles sets, based on the on param, whether the
+// styles of the underline are emitted, in the colon separated form
+// ESC[4:<style>m. Terminals that read the colon as a semicolon
+// would render ESC[4:3m as underline and italic, so it is off by
+// default, and every underline style is emitted as a single
+// underline. It is safe to call SetUnderlineStyles concurrently.
+func SetUnderlineStyles(on bool) { _UnderlineStyles.Store(on) }
+
+// UnderlineStyles reports whether the styles of the underline are
+// emitted, see [SetUnderlineStyles].
+func UnderlineStyles() bool { return _UnderlineStyles.Load() }
+
+// StyleUnderline returns an escape sequence that can put an
+// underline of the given style on the text.
+//
+// Unless enabled by [SetUnderlineStyles], it is a plain underline
+// sequence. Otherwise, as terminals that do not support the colon
+// separated form ignore it altogether, it is preceded by a plain
+// underline sequence as a fallback.
+func StyleUnderline(s UnderlineStyle) string {
+	switch {
+	case s <= UnderlineNone:
+		return _UnUnderline
+	case s == UnderlineSingle || !UnderlineStyles():
+		return _Underline
+	}
+
+	return _Underline + fmt.Sprintf(_StyleUnderline, s)
+}
+
+// UnderlineColor returns an escape sequence that can set the
+// color of the underline to the given color, without affecting
+// the color of the text. The color is downsampled according to
+// the current [Profile], and under [ProfileNoColor], an empty
+// string is returned.
+func UnderlineColor(c Color) string {
+	params := _ColorParams(c, _Underground)
+	if params == "" {
+		return ""
+	}
+	return _Csi + params + "m"
+}
+
+// UnUnderlineColor returns an escape sequence that can
+// restore the underline color to the color of the text.
+func UnUnderlineColor() string { return _UnUnderlineColor }
+
 // HyperLink returns an escape sequence that can turn the
 // given text into a hyperlink that points to the given link.
 func HyperLink(link, text string) string {
@@ -0,0 +1,9 @@
+//go:build !unix && !windows
+
+package ansi
+
+// _IsTerminal always reports false, terminals are not supported
+// on this platform.
+func _IsTerminal(_ uintptr) bool {
+	return false
+}
@@ -0,0 +1,12 @@
+//go:build unix
+
+package ansi
+
+import "golang.org/x/sys/unix"
+
+// _IsTerminal reports whether fd refers to a terminal. Other
+// character devices, like /dev/null, are not terminals.
+func _IsTerminal(fd uintptr) bool {
+	_, err := unix.IoctlGetTermios(int(fd), _IoctlReadTermios)
+	return err == nil
+}
@@ -0,0 +1,7 @@
+//go:build darwin || dragonfly || freebsd || netbsd || openbsd
+
+package ansi
+
+import "golang.org/x/sys/unix"
+
+const _IoctlReadTermios = unix.TIOCGETA
@@ -0,0 +1,7 @@
+//go:build aix || linux || solaris
+
+package ansi
+
+import "golang.org/x/sys/unix"
+
+const _IoctlReadTermios = unix.TCGETS
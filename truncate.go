@@ -0,0 +1,130 @@
+package ansi
+
+import (
+	"strings"
+)
+
+// Truncate cuts s so that it takes at most width cells, as
+// measured by [Width], appending tail (usually "…" or "...") in
+// place of the removed content. Escape sequences are never split,
+// and if the cut happens inside a styled span or a hyperlink, they
+// are closed after the tail, so no style bleeds onto what follows.
+// If s already fits in width, it is returned unchanged.
+func Truncate(s string, width int, tail string) string {
+	width = max(width, 0)
+	if Width(s) <= width {
+		return s
+	}
+
+	tail_width := Width(tail)
+	if tail_width > width {
+		tail = Truncate(tail, width, "")
+		tail_width = Width(tail)
+	}
+
+	var buf strings.Builder
+	var active _Active
+	left := width - tail_width
+
+	buf.Grow(len(s))
+
+cut:
+	for _, t := range Parse(s) {
+		if t.Kind != TokenText {
+			buf.WriteString(t.Raw)
+			active.Apply(&t)
+			continue
+		}
+
+		text := t.Raw
+		for len(text) > 0 {
+			n, w := _NextCluster(text)
+			if w > left {
+				break cut
+			}
+
+			buf.WriteString(text[:n])
+			left -= w
+			text = text[n:]
+		}
+	}
+
+	buf.WriteString(tail)
+	buf.WriteString(active.Close())
+	return buf.String()
+}
+
+// _Active keeps track of the graphic rendition and the hyperlink
+// in effect at some point of a string.
+type _Active struct {
+	sgr  []string // SGR sequences applied since the last reset
+	link string   // sequence that opened the current hyperlink
+}
+
+// Apply updates the state according to t.
+func (a *_Active) Apply(t *Token) {
+	switch {
+	case t.Kind == TokenCSI && t.Final == 'm' && t.Private == 0 && t.Intermediates == "":
+		if _ResetsSGR(t) {
+			a.sgr = a.sgr[:0]
+		}
+
+		if len(t.Params) > 1 || t.Param(0, 0) != 0 {
+			a.sgr = append(a.sgr, t.Raw)
+		}
+
+	case t.Kind == TokenOSC && strings.HasPrefix(t.Data, "8;"):
+		if _, uri, _ := strings.Cut(t.Data[2:], ";"); uri == "" {
+			a.link = ""
+		} else {
+			a.link = t.Raw
+		}
+	}
+}
+
+// Open returns the sequences that bring a terminal from its
+// default state to the current one.
+func (a *_Active) Open() string {
+	return strings.Join(a.sgr, "") + a.link
+}
+
+// Close returns the sequences that bring a terminal from the
+// current state back to its default one.
+func (a *_Active) Close() string {
+	var close string
+	if len(a.sgr) > 0 {
+		close += _Reset
+	}
+
+	if a.link != "" {
+		close += _LinkClose
+	}
+
+	return close
+}
+
+// _ResetsSGR reports whether the SGR sequence t resets all the
+// attributes at some point, skipping over color arguments.
+func _ResetsSGR(t *Token) bool {
+	if len(t.Params) == 0 {
+		return true
+	}
+
+	for i := 0; i < len(t.Params); i++ {
+		p := t.Param(i, 0)
+		switch {
+		case p == 0:
+			return true
+
+		case (p == 38 || p == 48 || p == 58) && len(t.Params[i]) == 1:
+			switch t.Param(i+1, 0) {
+			case 5:
+				i += 2
+			case 2:
+				i += 4
+			}
+		}
+	}
+
+	return false
+}
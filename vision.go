@@ -0,0 +1,112 @@
+package ansi
+
+// Deficiency is a kind of color vision deficiency, commonly known
+// as color blindness.
+type Deficiency int
+
+const (
+	// Protanopia is the lack of the long wavelength cones, those
+	// most sensitive to red.
+	Protanopia Deficiency = iota
+
+	// Deuteranopia is the lack of the medium wavelength cones,
+	// those most sensitive to green.
+	Deuteranopia
+
+	// Tritanopia is the lack of the short wavelength cones, those
+	// most sensitive to blue.
+	Tritanopia
+)
+
+// Simulate returns c as seen by someone with the given color
+// vision deficiency, using the model of Machado, Oliveira and
+// Fernandes (2009) at full severity. Unknown deficiencies leave c
+// unchanged.
+func Simulate(c Color, d Deficiency) RGB {
+	if d < Protanopia || d > Tritanopia {
+		return RGBFromColor(c)
+	}
+
+	m := &_Machado[d]
+	r, g, b := _LinearRGB(c)
+
+	R, G, B := _FromLinear(
+		m[0][0]*r+m[0][1]*g+m[0][2]*b,
+		m[1][0]*r+m[1][1]*g+m[1][2]*b,
+		m[2][0]*r+m[2][1]*g+m[2][2]*b,
+	)
+
+	return RGB{R, G, B}
+}
+
+// ConfusablePair is a pair of colors of a palette, given by their
+// indices, that become hard to tell apart under a color vision
+// deficiency, see [CheckPalette].
+type ConfusablePair struct {
+	A, B       int
+	Deficiency Deficiency
+}
+
+// CheckPalette reports the pairs of colors of palette that are
+// easily told apart by normal vision, but not under any of the
+// given color vision deficiencies, as measured by the euclidean
+// distance in the [OKLab] color space. If no deficiencies are
+// given, all of them are checked. Pairs are reported once for
+// each deficiency, with A < B.
+func CheckPalette(palette []Color, deficiencies ...Deficiency) []ConfusablePair {
+	if len(deficiencies) == 0 {
+		deficiencies = []Deficiency{Protanopia, Deuteranopia, Tritanopia}
+	}
+
+	labs := make([]OKLab, len(palette))
+	for i, c := range palette {
+		labs[i] = OKLabFromColor(c)
+	}
+
+	var pairs []ConfusablePair
+	for _, d := range deficiencies {
+		sims := make([]OKLab, len(palette))
+		for i, c := range palette {
+			sims[i] = OKLabFromColor(Simulate(c, d))
+		}
+
+		for a := range palette {
+			for b := a + 1; b < len(palette); b++ {
+				if _Distinct(labs[a], labs[b]) && !_Distinct(sims[a], sims[b]) {
+					pairs = append(pairs, ConfusablePair{a, b, d})
+				}
+			}
+		}
+	}
+
+	return pairs
+}
+
+// _Machado holds the matrices, in linear RGB, that simulate each
+// [Deficiency] at full severity.
+var _Machado = [...][3][3]float64{
+	Protanopia: {
+		{+0.152286, +1.052583, -0.204868},
+		{+0.114503, +0.786281, +0.099216},
+		{-0.003882, -0.048116, +1.051998},
+	},
+	Deuteranopia: {
+		{+0.367322, +0.860646, -0.227968},
+		{+0.280085, +0.672501, +0.047413},
+		{-0.011820, +0.042940, +0.968881},
+	},
+	Tritanopia: {
+		{+1.255528, -0.076749, -0.178779},
+		{-0.078411, +0.930809, +0.147602},
+		{+0.004733, +0.691367, +0.303900},
+	},
+}
+
+// _MinDistance is the distance, in the [OKLab] color space, from
+// which two colors are deemed easy to tell apart.
+const _MinDistance = 0.1
+
+func _Distinct(a, b OKLab) bool {
+	dl, da, db := a.L-b.L, a.A-b.A, a.B-b.B
+	return dl*dl+da*da+db*db >= _MinDistance*_MinDistance
+}
@@ -46,3 +46,9 @@ func DisableVirtualTerminal(fd uintptr) error {
 	err = windows.SetConsoleMode(windows.Handle(fd), mode)
 	return err
 }
+
+// _IsTerminal reports whether fd refers to a console.
+func _IsTerminal(fd uintptr) bool {
+	var mode uint32
+	return windows.GetConsoleMode(windows.Handle(fd), &mode) == nil
+}
@@ -0,0 +1,180 @@
+package ansi
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Width returns the number of terminal cells taken by s, ignoring
+// escape sequences and control characters. East Asian wide and
+// fullwidth characters and emoji take two cells, while combining
+// marks, zero-width characters and emoji modifiers take none.
+// Emoji sequences joined by ZWJ (U+200D) and flags (pairs of
+// regional indicators) are counted as a single emoji.
+func Width(s string) int {
+	width := 0
+	for _, t := range Parse(s) {
+		if t.Kind == TokenText {
+			width += _TextWidth(t.Raw)
+		}
+	}
+
+	return width
+}
+
+// RuneWidth returns the number of terminal cells taken by r on its
+// own, that is 0, 1 or 2. See [Width] for details.
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x20 || r == 0x7F:
+		return 0
+	case r < 0x300:
+		return 1
+	case _IsZeroWidth(r):
+		return 0
+	case _InTable(r, _WideTable):
+		return 2
+	}
+
+	return 1
+}
+
+func _TextWidth(s string) int {
+	width := 0
+	for len(s) > 0 {
+		n, w := _NextCluster(s)
+		width += w
+		s = s[n:]
+	}
+
+	return width
+}
+
+const (
+	_ZWJ  = 0x200D
+	_VS15 = 0xFE0E
+	_VS16 = 0xFE0F
+)
+
+// _NextCluster returns the length in bytes and the width in cells
+// of the first cluster of s, that is, a base rune followed by the
+// runes that merge with it when rendered. This is a simplification
+// of the extended grapheme cluster algorithm of UAX #29.
+func _NextCluster(s string) (int, int) {
+	r, n := utf8.DecodeRuneInString(s)
+	width := RuneWidth(r)
+	regional := _IsRegional(r)
+
+	for n < len(s) {
+		next, size := utf8.DecodeRuneInString(s[n:])
+
+		switch {
+		case next == _ZWJ:
+			n += size
+			if n < len(s) {
+				_, size = utf8.DecodeRuneInString(s[n:])
+				n += size
+			}
+			continue
+
+		case next == _VS16:
+			if width == 1 {
+				width = 2
+			}
+
+		case next == _VS15, _IsEmojiModifier(next):
+
+		case regional && _IsRegional(next):
+			regional = false
+			width = 2
+
+		case _IsZeroWidth(next):
+
+		default:
+			return n, width
+		}
+
+		n += size
+	}
+
+	return n, width
+}
+
+func _IsZeroWidth(r rune) bool {
+	switch {
+	case r == 0x00AD:
+		return false
+	case 0x1160 <= r && r <= 0x11FF, r == 0x200B:
+		return true
+	}
+
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf)
+}
+
+func _IsRegional(r rune) bool { return 0x1F1E6 <= r && r <= 0x1F1FF }
+
+func _IsEmojiModifier(r rune) bool { return 0x1F3FB <= r && r <= 0x1F3FF }
+
+func _InTable(r rune, table [][2]rune) bool {
+	lo, hi := 0, len(table)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		switch {
+		case r < table[mid][0]:
+			hi = mid
+		case r > table[mid][1]:
+			lo = mid + 1
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// _WideTable holds, in ascending order, the ranges of runes with
+// East Asian Width property W or F, including those with the
+// Emoji_Presentation property, as of Unicode 15.1.
+var _WideTable = [][2]rune{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A},
+	{0x23E9, 0x23EC}, {0x23F0, 0x23F0}, {0x23F3, 0x23F3},
+	{0x25FD, 0x25FE}, {0x2614, 0x2615}, {0x2648, 0x2653},
+	{0x267F, 0x267F}, {0x2693, 0x2693}, {0x26A1, 0x26A1},
+	{0x26AA, 0x26AB}, {0x26BD, 0x26BE}, {0x26C4, 0x26C5},
+	{0x26CE, 0x26CE}, {0x26D4, 0x26D4}, {0x26EA, 0x26EA},
+	{0x26F2, 0x26F3}, {0x26F5, 0x26F5}, {0x26FA, 0x26FA},
+	{0x26FD, 0x26FD}, {0x2705, 0x2705}, {0x270A, 0x270B},
+	{0x2728, 0x2728}, {0x274C, 0x274C}, {0x274E, 0x274E},
+	{0x2753, 0x2755}, {0x2757, 0x2757}, {0x2795, 0x2797},
+	{0x27B0, 0x27B0}, {0x27BF, 0x27BF}, {0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x2E80, 0x2E99},
+	{0x2E9B, 0x2EF3}, {0x2F00, 0x2FD5}, {0x2FF0, 0x2FFF},
+	{0x3000, 0x303E}, {0x3041, 0x3096}, {0x3099, 0x30FF},
+	{0x3105, 0x312F}, {0x3131, 0x318E}, {0x3190, 0x31E3},
+	{0x31EF, 0x321E}, {0x3220, 0x3247}, {0x3250, 0x4DBF},
+	{0x4E00, 0xA48C}, {0xA490, 0xA4C6}, {0xA960, 0xA97C},
+	{0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19},
+	{0xFE30, 0xFE52}, {0xFE54, 0xFE66}, {0xFE68, 0xFE6B},
+	{0xFF01, 0xFF60}, {0xFFE0, 0xFFE6}, {0x16FE0, 0x16FE4},
+	{0x16FF0, 0x16FF1}, {0x17000, 0x187F7}, {0x18800, 0x18CD5},
+	{0x18D00, 0x18D08}, {0x1AFF0, 0x1AFF3}, {0x1AFF5, 0x1AFFB},
+	{0x1AFFD, 0x1AFFE}, {0x1B000, 0x1B122}, {0x1B132, 0x1B132},
+	{0x1B150, 0x1B152}, {0x1B155, 0x1B155}, {0x1B164, 0x1B167},
+	{0x1B170, 0x1B2FB}, {0x1F004, 0x1F004}, {0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F202},
+	{0x1F210, 0x1F23B}, {0x1F240, 0x1F248}, {0x1F250, 0x1F251},
+	{0x1F260, 0x1F265}, {0x1F300, 0x1F320}, {0x1F32D, 0x1F335},
+	{0x1F337, 0x1F37C}, {0x1F37E, 0x1F393}, {0x1F3A0, 0x1F3CA},
+	{0x1F3CF, 0x1F3D3}, {0x1F3E0, 0x1F3F0}, {0x1F3F4, 0x1F3F4},
+	{0x1F3F8, 0x1F43E}, {0x1F440, 0x1F440}, {0x1F442, 0x1F4FC},
+	{0x1F4FF, 0x1F53D}, {0x1F54B, 0x1F54E}, {0x1F550, 0x1F567},
+	{0x1F57A, 0x1F57A}, {0x1F595, 0x1F596}, {0x1F5A4, 0x1F5A4},
+	{0x1F5FB, 0x1F64F}, {0x1F680, 0x1F6C5}, {0x1F6CC, 0x1F6CC},
+	{0x1F6D0, 0x1F6D2}, {0x1F6D5, 0x1F6D7}, {0x1F6DC, 0x1F6DF},
+	{0x1F6EB, 0x1F6EC}, {0x1F6F4, 0x1F6FC}, {0x1F7E0, 0x1F7EB},
+	{0x1F7F0, 0x1F7F0}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF}, {0x1FA70, 0x1FA7C}, {0x1FA80, 0x1FA88},
+	{0x1FA90, 0x1FABD}, {0x1FABF, 0x1FAC5}, {0x1FACE, 0x1FADB},
+	{0x1FAE0, 0x1FAE8}, {0x1FAF0, 0x1FAF8}, {0x20000, 0x2FFFD},
+	{0x30000, 0x3FFFD},
+}
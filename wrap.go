@@ -0,0 +1,170 @@
+package ansi
+
+import (
+	"strings"
+)
+
+// Wrap hard wraps s so that no line takes more than width cells,
+// as measured by [Width], breaking lines at any character. The
+// style and hyperlink in effect are closed at the end of each line
+// and reopened at the start of the next one. Existing line breaks
+// are kept. If width is less than 1, s is returned unchanged.
+func Wrap(s string, width int) string {
+	if width < 1 {
+		return s
+	}
+
+	w := _Wrapper{width: width}
+	w.buf.Grow(len(s))
+
+	tokens := Parse(s)
+	for i := range tokens {
+		t := &tokens[i]
+		switch {
+		case t.Kind == TokenText:
+			for text := t.Raw; len(text) > 0; {
+				n, cw := _NextCluster(text)
+				w.Place(_Piece{text: text[:n], width: cw})
+				text = text[n:]
+			}
+
+		case t.Raw == "\n":
+			w.Break()
+
+		default:
+			w.Place(_Piece{tok: t})
+		}
+	}
+
+	return w.buf.String()
+}
+
+// WordWrap wraps s so that no line takes more than width cells,
+// as measured by [Width], breaking lines at spaces. Spaces at the
+// point of a break are removed, and words longer than width are
+// broken at any character. The style and hyperlink in effect are
+// closed at the end of each line and reopened at the start of the
+// next one. Existing line breaks are kept. If width is less than
+// 1, s is returned unchanged.
+func WordWrap(s string, width int) string {
+	if width < 1 {
+		return s
+	}
+
+	w := _Wrapper{width: width}
+	w.buf.Grow(len(s))
+
+	var word, space []_Piece
+	var word_width, space_width int
+
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+
+		fits := w.col == 0 || w.col+space_width+word_width <= width
+		if !fits {
+			w.Break()
+		}
+
+		for _, p := range space {
+			if fits || p.tok != nil {
+				w.Place(p)
+			}
+		}
+
+		for _, p := range word {
+			w.Place(p)
+		}
+
+		word, space = word[:0], space[:0]
+		word_width, space_width = 0, 0
+	}
+
+	end := func() {
+		flush()
+
+		for _, p := range space {
+			if p.tok != nil || w.col+p.width <= width {
+				w.Place(p)
+			}
+		}
+
+		space, space_width = space[:0], 0
+	}
+
+	tokens := Parse(s)
+	for i := range tokens {
+		t := &tokens[i]
+		switch {
+		case t.Kind == TokenText:
+			for text := t.Raw; len(text) > 0; {
+				n, cw := _NextCluster(text)
+				p := _Piece{text: text[:n], width: cw}
+				text = text[n:]
+
+				if p.text == " " {
+					flush()
+					space = append(space, p)
+					space_width += p.width
+				} else {
+					word = append(word, p)
+					word_width += p.width
+				}
+			}
+
+		case t.Raw == "\n":
+			end()
+			w.Break()
+
+		case len(word) > 0:
+			word = append(word, _Piece{tok: t})
+
+		default:
+			space = append(space, _Piece{tok: t})
+		}
+	}
+
+	end()
+	return w.buf.String()
+}
+
+// _Piece is either a cluster of text or a zero-width token.
+type _Piece struct {
+	tok   *Token
+	text  string
+	width int
+}
+
+// _Wrapper lays pieces out in lines of limited width.
+type _Wrapper struct {
+	buf    strings.Builder
+	active _Active
+	width  int
+	col    int
+}
+
+// Place writes p to the current line, breaking it beforehand if p
+// does not fit.
+func (w *_Wrapper) Place(p _Piece) {
+	if p.tok != nil {
+		w.buf.WriteString(p.tok.Raw)
+		w.active.Apply(p.tok)
+		return
+	}
+
+	if w.col > 0 && w.col+p.width > w.width {
+		w.Break()
+	}
+
+	w.buf.WriteString(p.text)
+	w.col += p.width
+}
+
+// Break ends the current line and starts a new one.
+func (w *_Wrapper) Break() {
+	w.buf.WriteString(w.active.Close())
+	w.buf.WriteByte('\n')
+	w.buf.WriteString(w.active.Open())
+	w.col = 0
+}